@@ -24,11 +24,15 @@ import (
 // NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
 
 // AliasSpec defines the desired state of Alias.
+// +kubebuilder:validation:XValidation:rule="has(self.mailcow) != has(self.mailcowRef)",message="exactly one of mailcow or mailcowRef must be set"
 type AliasSpec struct {
 	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
 	// Important: Run "make" to regenerate code after modifying this file
 
-	Mailcow string `json:"mailcow"`
+	// Mailcow is the name of the Mailcow in the same namespace, use mailcowRef to use a ClusterMailcow.
+	Mailcow string `json:"mailcow,omitempty"`
+	// MailcowRef references the Mailcow or ClusterMailcow, instead of mailcow.
+	MailcowRef *MailcowReference `json:"mailcowRef,omitempty"`
 
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Address is immutable"
 	Address string `json:"address"`
@@ -36,6 +40,12 @@ type AliasSpec struct {
 
 	// +kubebuilder:default:=true
 	Active bool `json:"active"`
+
+	// ResyncInterval overrides the resyncInterval of the Mailcow, 0 disables the resync.
+	ResyncInterval *metav1.Duration `json:"resyncInterval,omitempty"`
+
+	// DeletionPolicy overrides the deletionPolicy of the Mailcow.
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
 }
 
 // AliasStatus defines the observed state of Alias.
@@ -72,3 +82,8 @@ type AliasList struct {
 func init() {
 	SchemeBuilder.Register(&Alias{}, &AliasList{})
 }
+
+// GetMailcowRef returns the reference to the Mailcow or ClusterMailcow of the alias.
+func (alias *Alias) GetMailcowRef() MailcowReference {
+	return newMailcowReference(alias.Spec.Mailcow, alias.Spec.MailcowRef)
+}
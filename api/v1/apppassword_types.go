@@ -0,0 +1,134 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
+// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+
+// AppPasswordSpec defines the desired state of AppPassword.
+type AppPasswordSpec struct {
+	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+
+	// Mailbox is the name of the Mailbox resource the app password belongs to.
+	// The mailcow instance is taken from the Mailbox.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Mailbox is immutable"
+	Mailbox string `json:"mailbox"`
+
+	// AppName is the name of the app password in mailcow, defaults to the name of the resource.
+	AppName string `json:"appName,omitempty"`
+
+	// PasswordSecret holds the app password. When not set, a password is generated.
+	PasswordSecret *corev1.SecretKeySelector `json:"passwordSecret,omitempty"`
+
+	// +kubebuilder:default:={imap,smtp}
+	Protocols []AppPasswordProtocol `json:"protocols,omitempty"`
+
+	// SecretName is the name of the Secret the credentials are written to, defaults to the name of the resource.
+	SecretName string `json:"secretName,omitempty"`
+
+	// +kubebuilder:default:=true
+	Active *bool `json:"active,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=imap;pop3;smtp;sieve;dav;eas
+type AppPasswordProtocol string
+
+// AppPasswordStatus defines the observed state of AppPassword.
+type AppPasswordStatus struct {
+	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+	// +kubebuilder:validation:Enum=Progressing;Ready;Degraded
+	Phase string `json:"phase,omitempty"`
+	// +kubebuilder:validation:Optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// ID is the id of the app password in mailcow.
+	ID *int `json:"id,omitempty"`
+	// SecretName is the name of the Secret holding the credentials.
+	SecretName string `json:"secretName,omitempty"`
+	// Hash of the last applied app password settings, salted with the uid, used to detect changes.
+	Hash string `json:"hash,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// AppPassword is the Schema for the apppasswords API.
+type AppPassword struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AppPasswordSpec   `json:"spec,omitempty"`
+	Status AppPasswordStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AppPasswordList contains a list of AppPassword.
+type AppPasswordList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AppPassword `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AppPassword{}, &AppPasswordList{})
+}
+
+func (apppassword *AppPassword) GetAppName() string {
+	if apppassword.Spec.AppName != "" {
+		return apppassword.Spec.AppName
+	}
+	return apppassword.Name
+}
+
+func (apppassword *AppPassword) GetSecretName() string {
+	if apppassword.Spec.SecretName != "" {
+		return apppassword.Spec.SecretName
+	}
+	return apppassword.Name
+}
+
+// GetPassword returns the password from the referenced secret, or an empty string if no secret is referenced.
+func (apppassword *AppPassword) GetPassword(ctx context.Context, r client.Reader) (string, error) {
+	if apppassword.Spec.PasswordSecret == nil {
+		return "", nil
+	}
+
+	var secret corev1.Secret
+	if err := r.Get(ctx, types.NamespacedName{Name: apppassword.Spec.PasswordSecret.Name, Namespace: apppassword.Namespace}, &secret); err != nil {
+		return "", err
+	}
+
+	value, ok := secret.Data[apppassword.Spec.PasswordSecret.Key]
+	if !ok {
+		return "", fmt.Errorf("key `%s` not found in secret `%s`", apppassword.Spec.PasswordSecret.Key, secret.Name)
+	}
+
+	return string(value), nil
+}
@@ -0,0 +1,161 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
+// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+
+// BCCMapSpec defines the desired state of BCCMap.
+// +kubebuilder:validation:XValidation:rule="has(self.mailcow) != has(self.mailcowRef)",message="exactly one of mailcow or mailcowRef must be set"
+// +kubebuilder:validation:XValidation:rule="has(self.localDest) != has(self.localDestRef)",message="exactly one of localDest or localDestRef must be set"
+type BCCMapSpec struct {
+	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+
+	// Mailcow is the name of the Mailcow in the same namespace, use mailcowRef to use a ClusterMailcow.
+	Mailcow string `json:"mailcow,omitempty"`
+	// MailcowRef references the Mailcow or ClusterMailcow, instead of mailcow.
+	MailcowRef *MailcowReference `json:"mailcowRef,omitempty"`
+
+	// LocalDest is the domain or email address whose mail is copied.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="LocalDest is immutable"
+	LocalDest string `json:"localDest,omitempty"`
+	// LocalDestRef references the Domain or Mailbox whose mail is copied, instead of localDest.
+	// The map is deleted together with the referenced resource.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="LocalDestRef is immutable"
+	LocalDestRef *LocalDestReference `json:"localDestRef,omitempty"`
+
+	// BCCDest is the email address the copies are sent to.
+	BCCDest string `json:"bccDest"`
+
+	// Type copies the mail sent by the local destination with sender, or the mail it receives with recipient.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Type is immutable"
+	Type BCCMapType `json:"type"`
+
+	// +kubebuilder:default:=true
+	Active *bool `json:"active,omitempty"`
+
+	// ResyncInterval overrides the resyncInterval of the Mailcow, 0 disables the resync.
+	ResyncInterval *metav1.Duration `json:"resyncInterval,omitempty"`
+
+	// DeletionPolicy overrides the deletionPolicy of the Mailcow.
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
+}
+
+// BCCMapType is the direction of the mail a BCC map copies.
+// +kubebuilder:validation:Enum=sender;recipient
+type BCCMapType string
+
+const (
+	BCCMapTypeSender    BCCMapType = "sender"
+	BCCMapTypeRecipient BCCMapType = "recipient"
+)
+
+// LocalDestReference references a Domain or Mailbox in the same namespace.
+type LocalDestReference struct {
+	// +kubebuilder:validation:Enum=Domain;Mailbox
+	Kind string `json:"kind"`
+	Name string `json:"name"`
+}
+
+// BCCMapStatus defines the observed state of BCCMap.
+type BCCMapStatus struct {
+	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+	// +kubebuilder:validation:Enum=Progressing;Ready;Degraded
+	Phase string `json:"phase,omitempty"`
+	// +kubebuilder:validation:Optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// ID is the id of the BCC map in mailcow.
+	ID *int `json:"id,omitempty"`
+	// LocalDest is the domain or email address the map is created for, resolved from localDestRef.
+	LocalDest string `json:"localDest,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Local Dest",type=string,JSONPath=`.status.localDest`
+// +kubebuilder:printcolumn:name="BCC Dest",type=string,JSONPath=`.spec.bccDest`
+// +kubebuilder:printcolumn:name="Type",type=string,JSONPath=`.spec.type`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+
+// BCCMap is the Schema for the bccmaps API.
+type BCCMap struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BCCMapSpec   `json:"spec,omitempty"`
+	Status BCCMapStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BCCMapList contains a list of BCCMap.
+type BCCMapList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BCCMap `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&BCCMap{}, &BCCMapList{})
+}
+
+// GetMailcowRef returns the reference to the Mailcow or ClusterMailcow of the BCC map.
+func (bccmap *BCCMap) GetMailcowRef() MailcowReference {
+	return newMailcowReference(bccmap.Spec.Mailcow, bccmap.Spec.MailcowRef)
+}
+
+// GetLocalDest returns the local destination of the BCC map and the resource it is resolved from, which is nil when
+// localDest is set. A domain is returned as is and a mailbox as its email address.
+func (bccmap *BCCMap) GetLocalDest(ctx context.Context, r client.Reader) (string, client.Object, error) {
+	return resolveLocalDest(ctx, r, bccmap.Namespace, bccmap.Spec.LocalDest, bccmap.Spec.LocalDestRef)
+}
+
+// resolveLocalDest returns the domain or email address of a local destination, and the resource it is resolved from.
+func resolveLocalDest(ctx context.Context, r client.Reader, namespace, localDest string, ref *LocalDestReference) (string, client.Object, error) {
+	if ref == nil {
+		return localDest, nil, nil
+	}
+
+	name := types.NamespacedName{Name: ref.Name, Namespace: namespace}
+	switch ref.Kind {
+	case "Domain":
+		var domain Domain
+		if err := r.Get(ctx, name, &domain); err != nil {
+			return "", nil, err
+		}
+		return domain.Spec.Domain, &domain, nil
+	case "Mailbox":
+		var mailbox Mailbox
+		if err := r.Get(ctx, name, &mailbox); err != nil {
+			return "", nil, err
+		}
+		return mailbox.Spec.LocalPart + "@" + mailbox.Spec.Domain, &mailbox, nil
+	}
+	return "", nil, fmt.Errorf("unsupported localDestRef kind `%s`", ref.Kind)
+}
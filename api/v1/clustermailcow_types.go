@@ -0,0 +1,159 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/tarteo/mailcow-operator/mailcow"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
+// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+
+// ClusterMailcowSpec defines the desired state of ClusterMailcow.
+type ClusterMailcowSpec struct {
+	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+
+	MailcowSpec `json:",inline"`
+
+	// Namespace is the namespace the API key secret, the CA bundle and the client certificate are read from.
+	Namespace string `json:"namespace"`
+
+	// AllowedNamespaces are the namespaces whose resources may use this mailcow, no namespace may when it is not set.
+	AllowedNamespaces *AllowedNamespaces `json:"allowedNamespaces,omitempty"`
+}
+
+// AllowedNamespaces selects namespaces by name or by label, a namespace is allowed when either matches.
+type AllowedNamespaces struct {
+	// Names of the allowed namespaces.
+	Names []string `json:"names,omitempty"`
+	// Selector matches the labels of the allowed namespaces, an empty selector allows all namespaces.
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:printcolumn:name="Endpoint",type=string,JSONPath=`.spec.endpoint`
+// +kubebuilder:printcolumn:name="Version",type=string,JSONPath=`.status.version`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+
+// ClusterMailcow is the Schema for the clustermailcows API.
+// It is a mailcow instance shared by the resources in the allowed namespaces.
+type ClusterMailcow struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClusterMailcowSpec `json:"spec,omitempty"`
+	Status MailcowStatus      `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterMailcowList contains a list of ClusterMailcow.
+type ClusterMailcowList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterMailcow `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ClusterMailcow{}, &ClusterMailcowList{})
+}
+
+// AllowsNamespace returns whether the resources in namespace may use this mailcow.
+func (res *ClusterMailcow) AllowsNamespace(ctx context.Context, r client.Reader, namespace string) (bool, error) {
+	return res.Spec.AllowedNamespaces.Allows(ctx, r, namespace)
+}
+
+// Allows returns whether the namespace is listed or matched by the selector, no namespace is allowed when nil.
+func (allowed *AllowedNamespaces) Allows(ctx context.Context, r client.Reader, namespace string) (bool, error) {
+	if allowed == nil {
+		return false, nil
+	}
+	if slices.Contains(allowed.Names, namespace) {
+		return true, nil
+	}
+	if allowed.Selector == nil {
+		return false, nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(allowed.Selector)
+	if err != nil {
+		return false, err
+	}
+	var ns corev1.Namespace
+	if err := r.Get(ctx, types.NamespacedName{Name: namespace}, &ns); err != nil {
+		return false, err
+	}
+	return selector.Matches(labels.Set(ns.Labels)), nil
+}
+
+// GetMailcowSpec returns the spec of the mailcow.
+func (res *ClusterMailcow) GetMailcowSpec() *MailcowSpec {
+	return &res.Spec.MailcowSpec
+}
+
+// GetMailcowStatus returns the status of the mailcow.
+func (res *ClusterMailcow) GetMailcowStatus() *MailcowStatus {
+	return &res.Status
+}
+
+// GetClient returns a new client for the mailcow, use a shared client from the client registry in the controllers.
+func (res *ClusterMailcow) GetClient(ctx context.Context, r client.Reader) (*mailcow.ClientWithResponses, error) {
+	return res.Spec.getClient(ctx, r, res.Spec.Namespace)
+}
+
+// GetAPIKey returns the API key and the resourceVersion of the secret it is read from.
+func (res *ClusterMailcow) GetAPIKey(ctx context.Context, r client.Reader) (string, string, error) {
+	return res.Spec.getAPIKey(ctx, r, res.Spec.Namespace)
+}
+
+// GetClientOptions returns the client options of the spec, with the referenced CA bundle and client certificate.
+func (res *ClusterMailcow) GetClientOptions(ctx context.Context, r client.Reader) ([]mailcow.CustomClientOption, string, error) {
+	return res.Spec.getClientOptions(ctx, r, res.Spec.Namespace)
+}
+
+// GetClientVersion returns a version that changes whenever the spec or one of the objects the client is built from
+// changes, without reading the API key or parsing the certificates.
+func (res *ClusterMailcow) GetClientVersion(ctx context.Context, r client.Reader) (string, error) {
+	return res.Spec.getClientVersion(ctx, r, res.Spec.Namespace, res.Generation)
+}
+
+// GetHostname returns the hostname of the mailcow endpoint.
+func (res *ClusterMailcow) GetHostname() string {
+	return res.Spec.getHostname()
+}
+
+// GetResyncInterval returns the resync interval of a resource, the override of the resource takes precedence.
+func (res *ClusterMailcow) GetResyncInterval(override *metav1.Duration) time.Duration {
+	return res.Spec.getResyncInterval(override)
+}
+
+// GetDeletionPolicy returns the deletion policy of a resource, the override of the resource takes precedence.
+func (res *ClusterMailcow) GetDeletionPolicy(override DeletionPolicy) DeletionPolicy {
+	return res.Spec.getDeletionPolicy(override)
+}
@@ -17,18 +17,103 @@ limitations under the License.
 package v1
 
 import (
+	"context"
+	"fmt"
+	"strings"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
 // NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
 
+// DomainDNS configures the DNS records recommended for the domain.
+type DomainDNS struct {
+	// MailHostname is the hostname of the mail server, defaults to the hostname of the Mailcow endpoint.
+	MailHostname string `json:"mailHostname,omitempty"`
+	// +kubebuilder:default:=10
+	MXPriority int32 `json:"mxPriority,omitempty"`
+	// SPFIncludes are added as include mechanisms to the SPF record, e.g. _spf.google.com.
+	SPFIncludes []string `json:"spfIncludes,omitempty"`
+	// +kubebuilder:validation:Enum:="-all";"~all";"?all"
+	// +kubebuilder:default:="~all"
+	SPFPolicy string `json:"spfPolicy,omitempty"`
+	// +kubebuilder:validation:Enum:=none;quarantine;reject
+	// +kubebuilder:default:=quarantine
+	DMARCPolicy string `json:"dmarcPolicy,omitempty"`
+	// DMARCRua are the addresses aggregate DMARC reports are sent to.
+	DMARCRua []string `json:"dmarcRua,omitempty"`
+	// DMARCRuf are the addresses forensic DMARC reports are sent to.
+	DMARCRuf []string `json:"dmarcRuf,omitempty"`
+	// +kubebuilder:default:=3600
+	TTL int32 `json:"ttl,omitempty"`
+	// ExternalDNS publishes the records through an external-dns DNSEndpoint.
+	ExternalDNS *ExternalDNS `json:"externalDNS,omitempty"`
+}
+
+// ExternalDNS configures the DNSEndpoint created for external-dns.
+type ExternalDNS struct {
+	Enabled bool `json:"enabled"`
+	// Labels are added to the DNSEndpoint, e.g. to match the --label-filter of external-dns.
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// DomainDKIM configures the DKIM key of the domain.
+type DomainDKIM struct {
+	// Selector of the DKIM key, rotated keys get the date appended, e.g. dkim-20260101.
+	// +kubebuilder:default:=dkim
+	Selector string `json:"selector,omitempty"`
+	// KeySize of the DKIM key in bits, a key of another size is replaced through a rotation.
+	// +kubebuilder:validation:Enum:=1024;2048;3072;4096
+	// +kubebuilder:default:=2048
+	KeySize int32 `json:"keySize,omitempty"`
+	// RotationPeriod is how often the DKIM key is replaced, e.g. 8760h for yearly rotation. Not set disables the rotation.
+	RotationPeriod *metav1.Duration `json:"rotationPeriod,omitempty"`
+	// RotationOverlap is how long the new key is published next to the current key before mailcow switches to it.
+	// +kubebuilder:default:="48h"
+	RotationOverlap *metav1.Duration `json:"rotationOverlap,omitempty"`
+}
+
+// DKIMStatus is the observed state of the DKIM key and its rotation.
+type DKIMStatus struct {
+	// Selector of the DKIM key mailcow signs with.
+	Selector string `json:"selector,omitempty"`
+	// KeySize of the DKIM key mailcow signs with.
+	KeySize int32 `json:"keySize,omitempty"`
+	// LastRotation is when the current key was first seen or rotated in.
+	LastRotation *metav1.Time `json:"lastRotation,omitempty"`
+	// PendingSelector is the selector of the new key while it is published ahead of the switch.
+	PendingSelector string `json:"pendingSelector,omitempty"`
+	// PendingSince is when the new key was published.
+	PendingSince *metav1.Time `json:"pendingSince,omitempty"`
+	// PreviousSelector is the selector of the replaced key, its record stays published until PreviousUntil, so mail
+	// signed before the switch can still be verified.
+	PreviousSelector string `json:"previousSelector,omitempty"`
+	// PreviousTxt is the DKIM record of the replaced key.
+	PreviousTxt string `json:"previousTxt,omitempty"`
+	// PreviousUntil is when the record of the replaced key is removed from DNS.
+	PreviousUntil *metav1.Time `json:"previousUntil,omitempty"`
+}
+
+// DNSRecord is a DNS record in presentation format, e.g. name example.com, type MX and value 10 mail.example.com.
+type DNSRecord struct {
+	Name  string `json:"name"`
+	Type  string `json:"type"`
+	TTL   int32  `json:"ttl"`
+	Value string `json:"value"`
+}
+
 // DomainSpec defines the desired state of Domain.
+// +kubebuilder:validation:XValidation:rule="has(self.mailcow) != has(self.mailcowRef)",message="exactly one of mailcow or mailcowRef must be set"
 type DomainSpec struct {
 	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
 	// Important: Run "make" to regenerate code after modifying this file
 
-	Mailcow string `json:"mailcow"`
+	// Mailcow is the name of the Mailcow in the same namespace, use mailcowRef to use a ClusterMailcow.
+	Mailcow string `json:"mailcow,omitempty"`
+	// MailcowRef references the Mailcow or ClusterMailcow, instead of mailcow.
+	MailcowRef *MailcowReference `json:"mailcowRef,omitempty"`
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Domain is immutable"
 	Domain       string `json:"domain"`
 	Description  string `json:"description,omitempty"`
@@ -43,6 +128,28 @@ type DomainSpec struct {
 
 	// +kubebuilder:default:=true
 	Active *bool `json:"active,omitempty"`
+
+	// ResyncInterval overrides the resyncInterval of the Mailcow, 0 disables the resync.
+	ResyncInterval *metav1.Duration `json:"resyncInterval,omitempty"`
+
+	// DeletionPolicy overrides the deletionPolicy of the Mailcow.
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// DKIM configures the DKIM key of the domain.
+	// +kubebuilder:default:={}
+	DKIM *DomainDKIM `json:"dkim,omitempty"`
+
+	// DNS configures the DNS records rendered into the status and the dkim ConfigMap.
+	// +kubebuilder:default:={}
+	DNS *DomainDNS `json:"dns,omitempty"`
+
+	// SharedWith are the namespaces besides the namespace of the Domain whose mailboxes, aliases and domain admins may
+	// use the domain. Only used with a ClusterMailcow, the domain is owned by the namespace of the Domain.
+	SharedWith *AllowedNamespaces `json:"sharedWith,omitempty"`
+
+	// RelayHost is the name of the RelayHost in the same namespace the domain sends its mail through. When it is
+	// removed, the domain sends its mail directly again.
+	RelayHost string `json:"relayHost,omitempty"`
 }
 
 // DomainStatus defines the observed state of Domain.
@@ -53,6 +160,12 @@ type DomainStatus struct {
 	Phase string `json:"phase,omitempty"`
 	// +kubebuilder:validation:Optional
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// DKIM is the state of the DKIM key and its rotation.
+	DKIM *DKIMStatus `json:"dkim,omitempty"`
+	// DNSRecords are the DNS records recommended for the domain.
+	DNSRecords []DNSRecord `json:"dnsRecords,omitempty"`
+	// RelayHostID is the id of the relayhost the operator set on the domain in mailcow.
+	RelayHostID *int `json:"relayHostID,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -79,3 +192,99 @@ type DomainList struct {
 func init() {
 	SchemeBuilder.Register(&Domain{}, &DomainList{})
 }
+
+// GetMailcowRef returns the reference to the Mailcow or ClusterMailcow of the domain.
+func (domain *Domain) GetMailcowRef() MailcowReference {
+	return newMailcowReference(domain.Spec.Mailcow, domain.Spec.MailcowRef)
+}
+
+// SharesWith returns whether the resources in namespace may use the domain, the namespace of the Domain always may.
+func (domain *Domain) SharesWith(ctx context.Context, r client.Reader, namespace string) (bool, error) {
+	if namespace == domain.Namespace {
+		return true, nil
+	}
+	return domain.Spec.SharedWith.Allows(ctx, r, namespace)
+}
+
+// DomainNotAllowedError is returned when a resource uses a domain that is not owned by or shared with its namespace.
+type DomainNotAllowedError struct {
+	Domain    string
+	Namespace string
+	// Owner is the namespaced name of the Domain owning the domain, empty when no Domain manages it.
+	Owner string
+}
+
+func (e *DomainNotAllowedError) Error() string {
+	if e.Owner == "" {
+		return fmt.Sprintf("domain `%s` is not managed by a Domain resource, namespace `%s` is not allowed to use it", e.Domain, e.Namespace)
+	}
+	return fmt.Sprintf("domain `%s` is owned by Domain `%s` and not shared with namespace `%s`", e.Domain, e.Owner, e.Namespace)
+}
+
+// DomainClaimedError is returned when a Domain manages a domain that an older Domain already manages.
+type DomainClaimedError struct {
+	Domain string
+	// Owner is the namespaced name of the Domain managing the domain.
+	Owner string
+}
+
+func (e *DomainClaimedError) Error() string {
+	return fmt.Sprintf("domain `%s` is already managed by Domain `%s`", e.Domain, e.Owner)
+}
+
+// FindDomain returns the Domain resource for the domain name on the given mailcow, or nil if there is none.
+// The domains of a ClusterMailcow are looked up in all namespaces. When several Domains claim the domain, the oldest
+// one owns it.
+func FindDomain(ctx context.Context, r client.Reader, namespace string, ref MailcowReference, domain string) (*Domain, error) {
+	var opts []client.ListOption
+	if ref.Kind != ClusterMailcowKind {
+		opts = append(opts, client.InNamespace(namespace))
+	}
+	var domains DomainList
+	if err := r.List(ctx, &domains, opts...); err != nil {
+		return nil, err
+	}
+	var owner *Domain
+	for i := range domains.Items {
+		candidate := &domains.Items[i]
+		if candidate.GetMailcowRef() != ref || !strings.EqualFold(candidate.Spec.Domain, domain) {
+			continue
+		}
+		if owner == nil || olderDomain(candidate, owner) {
+			owner = candidate
+		}
+	}
+	return owner, nil
+}
+
+// olderDomain returns whether a was created before b, Domains created in the same second are ordered by name.
+func olderDomain(a, b *Domain) bool {
+	if !a.CreationTimestamp.Equal(&b.CreationTimestamp) {
+		return a.CreationTimestamp.Before(&b.CreationTimestamp)
+	}
+	return a.Namespace+"/"+a.Name < b.Namespace+"/"+b.Name
+}
+
+// CheckDomainOwnership returns a DomainNotAllowedError when the resources in namespace may not use the domain.
+// A Mailcow is only used from its own namespace, so only the domains of a ClusterMailcow are checked. These must be
+// managed by a Domain in the namespace or shared with it.
+func CheckDomainOwnership(ctx context.Context, r client.Reader, namespace string, ref MailcowReference, domain string) error {
+	if ref.Kind != ClusterMailcowKind {
+		return nil
+	}
+	owner, err := FindDomain(ctx, r, namespace, ref, domain)
+	if err != nil {
+		return err
+	}
+	if owner == nil {
+		return &DomainNotAllowedError{Domain: domain, Namespace: namespace}
+	}
+	allowed, err := owner.SharesWith(ctx, r, namespace)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return &DomainNotAllowedError{Domain: domain, Namespace: namespace, Owner: owner.Namespace + "/" + owner.Name}
+	}
+	return nil
+}
@@ -30,19 +30,31 @@ import (
 // NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
 
 // DomainAdminSpec defines the desired state of DomainAdmin.
+// +kubebuilder:validation:XValidation:rule="has(self.mailcow) != has(self.mailcowRef)",message="exactly one of mailcow or mailcowRef must be set"
 type DomainAdminSpec struct {
 	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
 	// Important: Run "make" to regenerate code after modifying this file
 
-	Mailcow string `json:"mailcow"`
+	// Mailcow is the name of the Mailcow in the same namespace, use mailcowRef to use a ClusterMailcow.
+	Mailcow string `json:"mailcow,omitempty"`
+	// MailcowRef references the Mailcow or ClusterMailcow, instead of mailcow.
+	MailcowRef *MailcowReference `json:"mailcowRef,omitempty"`
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Username is immutable"
 	Username       string                   `json:"username"`
 	PasswordSecret corev1.SecretKeySelector `json:"passwordSecret"`
+	// GeneratePassword creates the password secret with a generated password when it doesn't exist.
+	GeneratePassword *GeneratePassword `json:"generatePassword,omitempty"`
 
 	// +kubebuilder:default:=true
 	Active *bool `json:"active,omitempty"`
 
 	Domains []string `json:"domains"`
+
+	// ResyncInterval overrides the resyncInterval of the Mailcow, 0 disables the resync.
+	ResyncInterval *metav1.Duration `json:"resyncInterval,omitempty"`
+
+	// DeletionPolicy overrides the deletionPolicy of the Mailcow.
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
 }
 
 // DomainAdminStatus defines the observed state of DomainAdmin.
@@ -53,6 +65,9 @@ type DomainAdminStatus struct {
 	Phase string `json:"phase,omitempty"`
 	// +kubebuilder:validation:Optional
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// PasswordHash is a hash of the password last pushed to mailcow, used to detect a rotated secret.
+	PasswordHash string `json:"passwordHash,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -93,3 +108,8 @@ func (domainadmin *DomainAdmin) GetPassword(ctx context.Context, r client.Reader
 
 	return string(value), nil
 }
+
+// GetMailcowRef returns the reference to the Mailcow or ClusterMailcow of the domain admin.
+func (domainadmin *DomainAdmin) GetMailcowRef() MailcowReference {
+	return newMailcowReference(domainadmin.Spec.Mailcow, domainadmin.Spec.MailcowRef)
+}
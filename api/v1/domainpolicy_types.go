@@ -0,0 +1,95 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
+// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+
+// DomainPolicySpec defines the desired state of DomainPolicy.
+// +kubebuilder:validation:XValidation:rule="has(self.mailcow) != has(self.mailcowRef)",message="exactly one of mailcow or mailcowRef must be set"
+type DomainPolicySpec struct {
+	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+
+	// Mailcow is the name of the Mailcow in the same namespace, use mailcowRef to use a ClusterMailcow.
+	Mailcow string `json:"mailcow,omitempty"`
+	// MailcowRef references the Mailcow or ClusterMailcow, instead of mailcow.
+	MailcowRef *MailcowReference `json:"mailcowRef,omitempty"`
+
+	// Domain is the domain the allow and deny lists belong to.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Domain is immutable"
+	Domain string `json:"domain"`
+
+	// Allow are the senders that are never treated as spam, e.g. info@example.org or *@example.org.
+	Allow []string `json:"allow,omitempty"`
+
+	// Deny are the senders that are always rejected, e.g. info@example.org or *@example.org.
+	Deny []string `json:"deny,omitempty"`
+
+	// ResyncInterval overrides the resyncInterval of the Mailcow, 0 disables the resync.
+	ResyncInterval *metav1.Duration `json:"resyncInterval,omitempty"`
+
+	// DeletionPolicy overrides the deletionPolicy of the Mailcow. Domain policies can't be disabled, Disable leaves
+	// the lists untouched like Retain.
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
+}
+
+// DomainPolicyStatus defines the observed state of DomainPolicy.
+type DomainPolicyStatus struct {
+	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+	// +kubebuilder:validation:Enum=Progressing;Ready;Degraded
+	Phase string `json:"phase,omitempty"`
+	// +kubebuilder:validation:Optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Domain",type=string,JSONPath=`.spec.domain`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+
+// DomainPolicy is the Schema for the domainpolicies API.
+type DomainPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DomainPolicySpec   `json:"spec,omitempty"`
+	Status DomainPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DomainPolicyList contains a list of DomainPolicy.
+type DomainPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DomainPolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DomainPolicy{}, &DomainPolicyList{})
+}
+
+// GetMailcowRef returns the reference to the Mailcow or ClusterMailcow of the domain policy.
+func (domainPolicy *DomainPolicy) GetMailcowRef() MailcowReference {
+	return newMailcowReference(domainPolicy.Spec.Mailcow, domainPolicy.Spec.MailcowRef)
+}
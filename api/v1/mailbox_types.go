@@ -30,11 +30,15 @@ import (
 // NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
 
 // MailboxSpec defines the desired state of Mailbox.
+// +kubebuilder:validation:XValidation:rule="has(self.mailcow) != has(self.mailcowRef)",message="exactly one of mailcow or mailcowRef must be set"
 type MailboxSpec struct {
 	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
 	// Important: Run "make" to regenerate code after modifying this file
 
-	Mailcow string `json:"mailcow"`
+	// Mailcow is the name of the Mailcow in the same namespace, use mailcowRef to use a ClusterMailcow.
+	Mailcow string `json:"mailcow,omitempty"`
+	// MailcowRef references the Mailcow or ClusterMailcow, instead of mailcow.
+	MailcowRef *MailcowReference `json:"mailcowRef,omitempty"`
 
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Domain is immutable"
 	Domain string `json:"domain"`
@@ -44,6 +48,8 @@ type MailboxSpec struct {
 
 	Name           string                   `json:"name"`
 	PasswordSecret corev1.SecretKeySelector `json:"passwordSecret"`
+	// GeneratePassword creates the password secret with a generated password when it doesn't exist.
+	GeneratePassword *GeneratePassword `json:"generatePassword,omitempty"`
 
 	// +kubebuilder:default:=true
 	Active *bool `json:"active,omitempty"`
@@ -54,6 +60,27 @@ type MailboxSpec struct {
 	// +kubebuilder:default:=true
 	SogoAccess *bool     `json:"sogoAccess,omitempty"`
 	SenderACL  *[]string `json:"senderACL,omitempty"`
+
+	// ResyncInterval overrides the resyncInterval of the Mailcow, 0 disables the resync.
+	ResyncInterval *metav1.Duration `json:"resyncInterval,omitempty"`
+
+	// DeletionPolicy overrides the deletionPolicy of the Mailcow.
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// Usage configures the quota usage and activity reported in the status.
+	// +kubebuilder:default:={}
+	Usage *MailboxUsage `json:"usage,omitempty"`
+}
+
+// MailboxUsage configures how the usage of the mailbox is observed.
+type MailboxUsage struct {
+	// RefreshInterval is how often the usage in the status is refreshed, defaults to the resyncInterval.
+	RefreshInterval *metav1.Duration `json:"refreshInterval,omitempty"`
+	// QuotaNearlyFullThreshold is the percentage of the quota in use from which the QuotaNearlyFull condition is set.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:default:=90
+	QuotaNearlyFullThreshold int32 `json:"quotaNearlyFullThreshold,omitempty"`
 }
 
 // MailboxStatus defines the observed state of Mailbox.
@@ -64,10 +91,34 @@ type MailboxStatus struct {
 	Phase string `json:"phase,omitempty"`
 	// +kubebuilder:validation:Optional
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// PasswordHash is a hash of the password last pushed to mailcow, used to detect a rotated secret.
+	PasswordHash string `json:"passwordHash,omitempty"`
+
+	// Quota is the quota of the mailbox in bytes, 0 means unlimited.
+	Quota *int64 `json:"quota,omitempty"`
+	// QuotaUsed is the storage used by the mailbox in bytes.
+	QuotaUsed *int64 `json:"quotaUsed,omitempty"`
+	// QuotaPercent is the percentage of the quota in use, not set for an unlimited quota.
+	QuotaPercent *int32 `json:"quotaPercent,omitempty"`
+	// Messages is the number of messages in the mailbox.
+	Messages *int64 `json:"messages,omitempty"`
+	// LastIMAPLogin is the time of the last IMAP login, not set when the mailbox never logged in.
+	LastIMAPLogin *metav1.Time `json:"lastIMAPLogin,omitempty"`
+	// LastPOP3Login is the time of the last POP3 login, not set when the mailbox never logged in.
+	LastPOP3Login *metav1.Time `json:"lastPOP3Login,omitempty"`
+	// LastSMTPLogin is the time of the last SMTP login, not set when the mailbox never logged in.
+	LastSMTPLogin *metav1.Time `json:"lastSMTPLogin,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Domain",type=string,JSONPath=`.spec.domain`
+// +kubebuilder:printcolumn:name="Local Part",type=string,JSONPath=`.spec.localPart`
+// +kubebuilder:printcolumn:name="Quota %",type=integer,JSONPath=`.status.quotaPercent`
+// +kubebuilder:printcolumn:name="Messages",type=integer,JSONPath=`.status.messages`
+// +kubebuilder:printcolumn:name="Last Login",type=date,JSONPath=`.status.lastIMAPLogin`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
 
 // Mailbox is the Schema for the mailboxes API.
 type Mailbox struct {
@@ -104,3 +155,24 @@ func (mailbox *Mailbox) GetPassword(ctx context.Context, r client.Reader) (strin
 
 	return string(value), nil
 }
+
+// GetMailcowRef returns the reference to the Mailcow or ClusterMailcow of the mailbox.
+func (mailbox *Mailbox) GetMailcowRef() MailcowReference {
+	return newMailcowReference(mailbox.Spec.Mailcow, mailbox.Spec.MailcowRef)
+}
+
+// GetUsageRefreshInterval returns how often the usage is refreshed, or nil to refresh it with the resync.
+func (mailbox *Mailbox) GetUsageRefreshInterval() *metav1.Duration {
+	if mailbox.Spec.Usage == nil {
+		return nil
+	}
+	return mailbox.Spec.Usage.RefreshInterval
+}
+
+// GetQuotaNearlyFullThreshold returns the percentage of the quota from which the quota is nearly full.
+func (mailbox *Mailbox) GetQuotaNearlyFullThreshold() int32 {
+	if mailbox.Spec.Usage == nil || mailbox.Spec.Usage.QuotaNearlyFullThreshold == 0 {
+		return 90
+	}
+	return mailbox.Spec.Usage.QuotaNearlyFullThreshold
+}
@@ -18,7 +18,13 @@ package v1
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/tarteo/mailcow-operator/mailcow"
 	corev1 "k8s.io/api/core/v1"
@@ -37,16 +43,216 @@ type MailcowSpec struct {
 
 	Secret   corev1.SecretKeySelector `json:"secret"`
 	Endpoint string                   `json:"endpoint"`
+
+	// HealthCheckInterval is how often the health of the mailcow instance is checked.
+	// +kubebuilder:default:="5m"
+	HealthCheckInterval *metav1.Duration `json:"healthCheckInterval,omitempty"`
+
+	// ResyncInterval is how often the resources of this mailcow are compared against mailcow to correct drift.
+	// Resources can override it with their own resyncInterval, 0 disables the resync.
+	// +kubebuilder:default:="10m"
+	ResyncInterval *metav1.Duration `json:"resyncInterval,omitempty"`
+
+	// DeletionPolicy is the default deletionPolicy of the resources of this mailcow.
+	// +kubebuilder:default:=Delete
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// RateLimit limits the requests the operator sends to this mailcow, shared by all resources.
+	// +kubebuilder:default:={}
+	RateLimit *MailcowRateLimit `json:"rateLimit,omitempty"`
+
+	// Timeout of a single request to mailcow, 0 disables the timeout.
+	// +kubebuilder:default:="30s"
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// TLS configures how the mailcow endpoint is verified and how the operator authenticates to it.
+	TLS *MailcowTLS `json:"tls,omitempty"`
+
+	// Proxy is the URL of the HTTP(S) proxy mailcow is reached through, e.g. http://proxy.example.com:3128.
+	// By default the HTTPS_PROXY, HTTP_PROXY and NO_PROXY environment variables of the operator are used.
+	Proxy string `json:"proxy,omitempty"`
+}
+
+// MailcowTLS configures the TLS connection to mailcow.
+type MailcowTLS struct {
+	// CA is a PEM bundle of certificate authorities trusted for the endpoint, in addition to the system roots.
+	CA *MailcowCABundle `json:"ca,omitempty"`
+	// InsecureSkipVerify disables the verification of the certificate of the endpoint, only use it for testing.
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
+	// ClientCertificate is a kubernetes.io/tls Secret with the client certificate and key presented to mailcow.
+	ClientCertificate *corev1.LocalObjectReference `json:"clientCertificate,omitempty"`
+}
+
+// MailcowCABundle references a PEM bundle in a ConfigMap or a Secret.
+// +kubebuilder:validation:XValidation:rule="has(self.configMap) != has(self.secret)",message="exactly one of configMap or secret must be set"
+type MailcowCABundle struct {
+	ConfigMap *corev1.ConfigMapKeySelector `json:"configMap,omitempty"`
+	Secret    *corev1.SecretKeySelector    `json:"secret,omitempty"`
+}
+
+// MailcowRateLimit limits the requests to a mailcow instance with a token bucket and a concurrency cap, 0 disables a limit.
+type MailcowRateLimit struct {
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:default:=10
+	RequestsPerSecond int32 `json:"requestsPerSecond,omitempty"`
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:default:=20
+	Burst int32 `json:"burst,omitempty"`
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:default:=5
+	MaxConcurrentRequests int32 `json:"maxConcurrentRequests,omitempty"`
+}
+
+// DeletionPolicy describes what happens in mailcow when a resource is deleted.
+// Delete removes the object from mailcow, Retain leaves it untouched and Disable sets it inactive.
+// +kubebuilder:validation:Enum=Delete;Retain;Disable
+type DeletionPolicy string
+
+const (
+	DeletionPolicyDelete  DeletionPolicy = "Delete"
+	DeletionPolicyRetain  DeletionPolicy = "Retain"
+	DeletionPolicyDisable DeletionPolicy = "Disable"
+)
+
+// GeneratePassword configures the password generated into the password secret when the secret doesn't exist.
+type GeneratePassword struct {
+	// Length of the generated password.
+	// +kubebuilder:validation:Minimum=8
+	// +kubebuilder:validation:Maximum=128
+	// +kubebuilder:default:=24
+	Length int32 `json:"length,omitempty"`
+	// Charset of the generated password, every password has a lowercase and an uppercase letter and a digit, and with
+	// Special also a special character.
+	// +kubebuilder:default:=Alphanumeric
+	Charset PasswordCharset `json:"charset,omitempty"`
+	// Labels are added to the generated secret, e.g. to select it from the workloads using the password.
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// PasswordCharset is the set of characters a password is generated from.
+// +kubebuilder:validation:Enum=Alphanumeric;Special
+type PasswordCharset string
+
+const (
+	PasswordCharsetAlphanumeric PasswordCharset = "Alphanumeric"
+	PasswordCharsetSpecial      PasswordCharset = "Special"
+)
+
+// MailcowReference references the Mailcow in the namespace of a resource, or a ClusterMailcow.
+type MailcowReference struct {
+	// +kubebuilder:validation:Enum=Mailcow;ClusterMailcow
+	// +kubebuilder:default:=Mailcow
+	Kind string `json:"kind,omitempty"`
+	Name string `json:"name"`
+}
+
+const (
+	MailcowKind        = "Mailcow"
+	ClusterMailcowKind = "ClusterMailcow"
+)
+
+// newMailcowReference returns the reference of a resource, the mailcow field is a reference to a Mailcow.
+func newMailcowReference(name string, ref *MailcowReference) MailcowReference {
+	if ref == nil {
+		return MailcowReference{Kind: MailcowKind, Name: name}
+	}
+	if ref.Kind == "" {
+		return MailcowReference{Kind: MailcowKind, Name: ref.Name}
+	}
+	return *ref
+}
+
+// String returns the reference as kind/name.
+func (ref MailcowReference) String() string {
+	return ref.Kind + "/" + ref.Name
+}
+
+// MailcowInstance is a Mailcow or a ClusterMailcow.
+// +kubebuilder:object:generate=false
+type MailcowInstance interface {
+	client.Object
+	GetMailcowSpec() *MailcowSpec
+	GetMailcowStatus() *MailcowStatus
+	GetClient(ctx context.Context, r client.Reader) (*mailcow.ClientWithResponses, error)
+	GetAPIKey(ctx context.Context, r client.Reader) (string, string, error)
+	GetClientOptions(ctx context.Context, r client.Reader) ([]mailcow.CustomClientOption, string, error)
+	GetClientVersion(ctx context.Context, r client.Reader) (string, error)
+	GetHostname() string
+	GetResyncInterval(override *metav1.Duration) time.Duration
+	GetDeletionPolicy(override DeletionPolicy) DeletionPolicy
+}
+
+// GetMailcow returns the Mailcow or ClusterMailcow referenced by a resource in namespace.
+// A ClusterMailcow is only returned when the namespace is allowed to use it.
+func GetMailcow(ctx context.Context, r client.Reader, namespace string, ref MailcowReference) (MailcowInstance, error) {
+	if ref.Kind == ClusterMailcowKind {
+		var res ClusterMailcow
+		if err := r.Get(ctx, types.NamespacedName{Name: ref.Name}, &res); err != nil {
+			return nil, err
+		}
+		allowed, err := res.AllowsNamespace(ctx, r, namespace)
+		if err != nil {
+			return nil, err
+		}
+		if !allowed {
+			return nil, fmt.Errorf("namespace `%s` is not allowed to use clustermailcow `%s`", namespace, res.Name)
+		}
+		return &res, nil
+	}
+
+	var res Mailcow
+	if err := r.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: namespace}, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
 }
 
 // MailcowStatus defines the observed state of Mailcow.
 type MailcowStatus struct {
 	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
 	// Important: Run "make" to regenerate code after modifying this file
+	// +kubebuilder:validation:Enum=Progressing;Ready;Degraded
+	Phase string `json:"phase,omitempty"`
+	// +kubebuilder:validation:Optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Version is the version of the mailcow instance.
+	Version string `json:"version,omitempty"`
+	// Containers is the state of the containers of the mailcow instance.
+	Containers []MailcowContainerStatus `json:"containers,omitempty"`
+	// Vmail is the disk usage of the vmail volume.
+	Vmail *MailcowVmailStatus `json:"vmail,omitempty"`
+	// Solr is the state of the full text search index.
+	Solr *MailcowSolrStatus `json:"solr,omitempty"`
+	// LastHealthCheck is the time the health of the mailcow instance was last checked.
+	LastHealthCheck *metav1.Time `json:"lastHealthCheck,omitempty"`
+}
+
+type MailcowContainerStatus struct {
+	Name      string `json:"name"`
+	State     string `json:"state,omitempty"`
+	Image     string `json:"image,omitempty"`
+	StartedAt string `json:"startedAt,omitempty"`
+}
+
+type MailcowVmailStatus struct {
+	Disk        string `json:"disk,omitempty"`
+	Total       string `json:"total,omitempty"`
+	Used        string `json:"used,omitempty"`
+	UsedPercent string `json:"usedPercent,omitempty"`
+}
+
+type MailcowSolrStatus struct {
+	Enabled   bool   `json:"enabled"`
+	Documents string `json:"documents,omitempty"`
+	Size      string `json:"size,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Endpoint",type=string,JSONPath=`.spec.endpoint`
+// +kubebuilder:printcolumn:name="Version",type=string,JSONPath=`.status.version`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
 
 // Mailcow is the Schema for the mailcows API.
 type Mailcow struct {
@@ -70,22 +276,231 @@ func init() {
 	SchemeBuilder.Register(&Mailcow{}, &MailcowList{})
 }
 
+// GetMailcowSpec returns the spec of the mailcow.
+func (res *Mailcow) GetMailcowSpec() *MailcowSpec {
+	return &res.Spec
+}
+
+// GetMailcowStatus returns the status of the mailcow.
+func (res *Mailcow) GetMailcowStatus() *MailcowStatus {
+	return &res.Status
+}
+
+// GetClient returns a new client for the mailcow, use a shared client from the client registry in the controllers.
 func (res *Mailcow) GetClient(ctx context.Context, r client.Reader) (*mailcow.ClientWithResponses, error) {
+	return res.Spec.getClient(ctx, r, res.Namespace)
+}
 
-	var secret corev1.Secret
-	if err := r.Get(ctx, types.NamespacedName{Name: res.Spec.Secret.Name, Namespace: res.Namespace}, &secret); err != nil {
+// GetAPIKey returns the API key and the resourceVersion of the secret it is read from.
+func (res *Mailcow) GetAPIKey(ctx context.Context, r client.Reader) (string, string, error) {
+	return res.Spec.getAPIKey(ctx, r, res.Namespace)
+}
+
+// GetClientOptions returns the client options of the spec, with the referenced CA bundle and client certificate.
+// The returned version changes whenever the spec or one of the referenced objects changes.
+func (res *Mailcow) GetClientOptions(ctx context.Context, r client.Reader) ([]mailcow.CustomClientOption, string, error) {
+	return res.Spec.getClientOptions(ctx, r, res.Namespace)
+}
+
+// GetClientVersion returns a version that changes whenever the spec or one of the objects the client is built from
+// changes, without reading the API key or parsing the certificates.
+func (res *Mailcow) GetClientVersion(ctx context.Context, r client.Reader) (string, error) {
+	return res.Spec.getClientVersion(ctx, r, res.Namespace, res.Generation)
+}
+
+// GetHostname returns the hostname of the mailcow endpoint.
+func (res *Mailcow) GetHostname() string {
+	return res.Spec.getHostname()
+}
+
+// GetResyncInterval returns the resync interval of a resource, the override of the resource takes precedence.
+func (res *Mailcow) GetResyncInterval(override *metav1.Duration) time.Duration {
+	return res.Spec.getResyncInterval(override)
+}
+
+// GetDeletionPolicy returns the deletion policy of a resource, the override of the resource takes precedence.
+func (res *Mailcow) GetDeletionPolicy(override DeletionPolicy) DeletionPolicy {
+	return res.Spec.getDeletionPolicy(override)
+}
+
+// getClient returns a new client for the spec, the referenced secrets are read from namespace.
+func (spec *MailcowSpec) getClient(ctx context.Context, r client.Reader, namespace string) (*mailcow.ClientWithResponses, error) {
+	apiKey, _, err := spec.getAPIKey(ctx, r, namespace)
+	if err != nil {
+		return nil, err
+	}
+	opts, _, err := spec.getClientOptions(ctx, r, namespace)
+	if err != nil {
 		return nil, err
 	}
-	value, ok := secret.Data[res.Spec.Secret.Key]
+	return mailcow.NewCustomClientWithResponses(spec.Endpoint, apiKey, opts...)
+}
+
+func (spec *MailcowSpec) getAPIKey(ctx context.Context, r client.Reader, namespace string) (string, string, error) {
+	var secret corev1.Secret
+	if err := r.Get(ctx, types.NamespacedName{Name: spec.Secret.Name, Namespace: namespace}, &secret); err != nil {
+		return "", "", err
+	}
+	value, ok := secret.Data[spec.Secret.Key]
 	if !ok {
-		return nil, fmt.Errorf("key `%s` not found in secret `%s`", res.Spec.Secret.Key, secret.Name)
+		return "", "", fmt.Errorf("key `%s` not found in secret `%s`", spec.Secret.Key, secret.Name)
 	}
-	apiKey := string(value)
-	endpoint := res.Spec.Endpoint
+	return string(value), secret.ResourceVersion, nil
+}
 
-	client, err := mailcow.NewCustomClientWithResponses(endpoint, apiKey)
-	if err != nil {
-		return nil, err
+func (spec *MailcowSpec) getClientOptions(ctx context.Context, r client.Reader, namespace string) ([]mailcow.CustomClientOption, string, error) {
+	var opts []mailcow.CustomClientOption
+	versions := []string{spec.Endpoint}
+
+	if limit := spec.RateLimit; limit != nil {
+		opts = append(opts,
+			mailcow.WithRateLimit(int(limit.RequestsPerSecond), int(limit.Burst)),
+			mailcow.WithMaxConcurrentRequests(int(limit.MaxConcurrentRequests)),
+		)
+		versions = append(versions, fmt.Sprintf("%+v", *limit))
+	}
+
+	if spec.Timeout != nil {
+		opts = append(opts, mailcow.WithTimeout(spec.Timeout.Duration))
+		versions = append(versions, spec.Timeout.Duration.String())
+	}
+
+	if spec.Proxy != "" {
+		proxy, err := url.Parse(spec.Proxy)
+		if err != nil {
+			return nil, "", fmt.Errorf("invalid proxy `%s`: %w", spec.Proxy, err)
+		}
+		opts = append(opts, mailcow.WithProxy(proxy))
+		versions = append(versions, spec.Proxy)
+	}
+
+	if tlsSpec := spec.TLS; tlsSpec != nil {
+		tlsConfig := &tls.Config{
+			MinVersion:         tls.VersionTLS12,
+			InsecureSkipVerify: tlsSpec.InsecureSkipVerify, //nolint:gosec // explicitly requested in the spec
+		}
+		versions = append(versions, fmt.Sprintf("insecureSkipVerify=%t", tlsSpec.InsecureSkipVerify))
+
+		if tlsSpec.CA != nil {
+			bundle, version, err := getCABundle(ctx, r, namespace, tlsSpec.CA)
+			if err != nil {
+				return nil, "", err
+			}
+			pool, err := x509.SystemCertPool()
+			if err != nil {
+				pool = x509.NewCertPool()
+			}
+			if !pool.AppendCertsFromPEM(bundle) {
+				return nil, "", fmt.Errorf("no certificates found in the CA bundle")
+			}
+			tlsConfig.RootCAs = pool
+			versions = append(versions, version)
+		}
+
+		if tlsSpec.ClientCertificate != nil {
+			var secret corev1.Secret
+			if err := r.Get(ctx, types.NamespacedName{Name: tlsSpec.ClientCertificate.Name, Namespace: namespace}, &secret); err != nil {
+				return nil, "", err
+			}
+			certificate, err := tls.X509KeyPair(secret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSPrivateKeyKey])
+			if err != nil {
+				return nil, "", fmt.Errorf("invalid client certificate in secret `%s`: %w", secret.Name, err)
+			}
+			tlsConfig.Certificates = []tls.Certificate{certificate}
+			versions = append(versions, "secret/"+secret.Name+"/"+secret.ResourceVersion)
+		}
+
+		opts = append(opts, mailcow.WithTLSConfig(tlsConfig))
+	}
+
+	return opts, strings.Join(versions, "\x00"), nil
+}
+
+// getClientVersion returns the generation of the resource and the resourceVersions of the referenced secrets and
+// configmaps, the objects are read from the cache.
+func (spec *MailcowSpec) getClientVersion(ctx context.Context, r client.Reader, namespace string, generation int64) (string, error) {
+	versions := []string{strconv.FormatInt(generation, 10)}
+	get := func(kind, name string, obj client.Object) error {
+		if err := r.Get(ctx, types.NamespacedName{Name: name, Namespace: namespace}, obj); err != nil {
+			return err
+		}
+		versions = append(versions, kind+"/"+name+"/"+obj.GetResourceVersion())
+		return nil
+	}
+
+	if err := get("secret", spec.Secret.Name, &corev1.Secret{}); err != nil {
+		return "", err
+	}
+	if tlsSpec := spec.TLS; tlsSpec != nil {
+		if ca := tlsSpec.CA; ca != nil && ca.ConfigMap != nil {
+			if err := get("configmap", ca.ConfigMap.Name, &corev1.ConfigMap{}); err != nil {
+				return "", err
+			}
+		} else if ca != nil && ca.Secret != nil {
+			if err := get("secret", ca.Secret.Name, &corev1.Secret{}); err != nil {
+				return "", err
+			}
+		}
+		if tlsSpec.ClientCertificate != nil {
+			if err := get("secret", tlsSpec.ClientCertificate.Name, &corev1.Secret{}); err != nil {
+				return "", err
+			}
+		}
+	}
+	return strings.Join(versions, ","), nil
+}
+
+// getCABundle returns the CA bundle and the version of the ConfigMap or Secret it is read from.
+func getCABundle(ctx context.Context, r client.Reader, namespace string, ca *MailcowCABundle) ([]byte, string, error) {
+	if ca.ConfigMap != nil {
+		var configMap corev1.ConfigMap
+		if err := r.Get(ctx, types.NamespacedName{Name: ca.ConfigMap.Name, Namespace: namespace}, &configMap); err != nil {
+			return nil, "", err
+		}
+		value, ok := configMap.Data[ca.ConfigMap.Key]
+		if !ok {
+			return nil, "", fmt.Errorf("key `%s` not found in configmap `%s`", ca.ConfigMap.Key, configMap.Name)
+		}
+		return []byte(value), "configmap/" + configMap.Name + "/" + configMap.ResourceVersion, nil
+	}
+	if ca.Secret != nil {
+		var secret corev1.Secret
+		if err := r.Get(ctx, types.NamespacedName{Name: ca.Secret.Name, Namespace: namespace}, &secret); err != nil {
+			return nil, "", err
+		}
+		value, ok := secret.Data[ca.Secret.Key]
+		if !ok {
+			return nil, "", fmt.Errorf("key `%s` not found in secret `%s`", ca.Secret.Key, secret.Name)
+		}
+		return value, "secret/" + secret.Name + "/" + secret.ResourceVersion, nil
+	}
+	return nil, "", fmt.Errorf("the CA bundle must reference a configmap or a secret")
+}
+
+func (spec *MailcowSpec) getHostname() string {
+	endpoint, err := url.Parse(spec.Endpoint)
+	if err != nil || endpoint.Hostname() == "" {
+		return spec.Endpoint
+	}
+	return endpoint.Hostname()
+}
+
+func (spec *MailcowSpec) getResyncInterval(override *metav1.Duration) time.Duration {
+	if override != nil {
+		return override.Duration
+	}
+	if spec.ResyncInterval != nil {
+		return spec.ResyncInterval.Duration
+	}
+	return 0
+}
+
+func (spec *MailcowSpec) getDeletionPolicy(override DeletionPolicy) DeletionPolicy {
+	if override != "" {
+		return override
+	}
+	if spec.DeletionPolicy != "" {
+		return spec.DeletionPolicy
 	}
-	return client, nil
+	return DeletionPolicyDelete
 }
@@ -0,0 +1,98 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
+// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+
+// RecipientMapSpec defines the desired state of RecipientMap.
+// +kubebuilder:validation:XValidation:rule="has(self.mailcow) != has(self.mailcowRef)",message="exactly one of mailcow or mailcowRef must be set"
+type RecipientMapSpec struct {
+	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+
+	// Mailcow is the name of the Mailcow in the same namespace, use mailcowRef to use a ClusterMailcow.
+	Mailcow string `json:"mailcow,omitempty"`
+	// MailcowRef references the Mailcow or ClusterMailcow, instead of mailcow.
+	MailcowRef *MailcowReference `json:"mailcowRef,omitempty"`
+
+	// OldRecipient is the email address or domain whose mail is rewritten.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="OldRecipient is immutable"
+	OldRecipient string `json:"oldRecipient"`
+
+	// NewRecipient is the email address the mail is delivered to instead.
+	NewRecipient string `json:"newRecipient"`
+
+	// +kubebuilder:default:=true
+	Active *bool `json:"active,omitempty"`
+
+	// ResyncInterval overrides the resyncInterval of the Mailcow, 0 disables the resync.
+	ResyncInterval *metav1.Duration `json:"resyncInterval,omitempty"`
+
+	// DeletionPolicy overrides the deletionPolicy of the Mailcow.
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
+}
+
+// RecipientMapStatus defines the observed state of RecipientMap.
+type RecipientMapStatus struct {
+	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+	// +kubebuilder:validation:Enum=Progressing;Ready;Degraded
+	Phase string `json:"phase,omitempty"`
+	// +kubebuilder:validation:Optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// ID is the id of the recipient map in mailcow.
+	ID *int `json:"id,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Old Recipient",type=string,JSONPath=`.spec.oldRecipient`
+// +kubebuilder:printcolumn:name="New Recipient",type=string,JSONPath=`.spec.newRecipient`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+
+// RecipientMap is the Schema for the recipientmaps API.
+type RecipientMap struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RecipientMapSpec   `json:"spec,omitempty"`
+	Status RecipientMapStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RecipientMapList contains a list of RecipientMap.
+type RecipientMapList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RecipientMap `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&RecipientMap{}, &RecipientMapList{})
+}
+
+// GetMailcowRef returns the reference to the Mailcow or ClusterMailcow of the recipient map.
+func (recipientMap *RecipientMap) GetMailcowRef() MailcowReference {
+	return newMailcowReference(recipientMap.Spec.Mailcow, recipientMap.Spec.MailcowRef)
+}
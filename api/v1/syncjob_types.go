@@ -0,0 +1,155 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
+// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+
+// SyncJobSpec defines the desired state of SyncJob.
+type SyncJobSpec struct {
+	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+
+	// Mailbox is the name of the Mailbox resource the messages are synced into.
+	// The mailcow instance is taken from the Mailbox.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Mailbox is immutable"
+	Mailbox string `json:"mailbox"`
+
+	// Host1 is the remote IMAP host messages are synced from.
+	Host1 string `json:"host1"`
+	// +kubebuilder:default:=993
+	Port1 int32 `json:"port1,omitempty"`
+	// +kubebuilder:validation:Enum:=SSL;TLS;PLAIN
+	// +kubebuilder:default:=SSL
+	Enc1 string `json:"enc1,omitempty"`
+	// User1 is the username on the remote IMAP host.
+	User1 string `json:"user1"`
+	// PasswordSecret holds the password on the remote IMAP host.
+	PasswordSecret corev1.SecretKeySelector `json:"passwordSecret"`
+
+	// +kubebuilder:default:=20
+	MinsInterval int64 `json:"minsInterval,omitempty"`
+	// MaxAge is the maximum age in days of messages that are synced, 0 syncs all messages.
+	// +kubebuilder:default:=0
+	MaxAge int64 `json:"maxAge,omitempty"`
+	// MaxBytesPerSecond limits the transfer speed, 0 is unlimited.
+	// +kubebuilder:default:=0
+	MaxBytesPerSecond int64 `json:"maxBytesPerSecond,omitempty"`
+	// +kubebuilder:default:=600
+	Timeout1 int64 `json:"timeout1,omitempty"`
+	// +kubebuilder:default:=600
+	Timeout2 int64 `json:"timeout2,omitempty"`
+	// Subfolder2 syncs into a subfolder on the mailbox, empty does not use a subfolder.
+	Subfolder2 string `json:"subfolder2,omitempty"`
+	// Exclude is a regex of folders that are not synced.
+	// +kubebuilder:default:="(?i)spam|(?i)junk"
+	Exclude      string `json:"exclude,omitempty"`
+	CustomParams string `json:"customParams,omitempty"`
+
+	// +kubebuilder:default:=true
+	Delete2Duplicates *bool `json:"delete2Duplicates,omitempty"`
+	// +kubebuilder:default:=false
+	Delete1 *bool `json:"delete1,omitempty"`
+	// +kubebuilder:default:=false
+	Delete2 *bool `json:"delete2,omitempty"`
+	// +kubebuilder:default:=true
+	Automap *bool `json:"automap,omitempty"`
+	// +kubebuilder:default:=false
+	SkipCrossDuplicates *bool `json:"skipCrossDuplicates,omitempty"`
+	// +kubebuilder:default:=true
+	SubscribeAll *bool `json:"subscribeAll,omitempty"`
+
+	// +kubebuilder:default:=true
+	Active *bool `json:"active,omitempty"`
+}
+
+// SyncJobStatus defines the observed state of SyncJob.
+type SyncJobStatus struct {
+	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+	// +kubebuilder:validation:Enum=Progressing;Ready;Degraded
+	Phase string `json:"phase,omitempty"`
+	// +kubebuilder:validation:Optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// ID is the id of the sync job in mailcow.
+	ID *int `json:"id,omitempty"`
+	// LastRun is the time the sync job last ran as reported by mailcow.
+	LastRun string `json:"lastRun,omitempty"`
+	// Running is true while the sync job is running.
+	Running bool `json:"running,omitempty"`
+	// Success is the result of the last run, nil if the sync job has not run yet.
+	Success *bool `json:"success,omitempty"`
+	// ExitStatus is the imapsync exit status of the last run.
+	ExitStatus string `json:"exitStatus,omitempty"`
+	// PasswordHash is a hash of the password last pushed to mailcow, used to detect a rotated secret.
+	PasswordHash string `json:"passwordHash,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Mailbox",type=string,JSONPath=`.spec.mailbox`
+// +kubebuilder:printcolumn:name="Host",type=string,JSONPath=`.spec.host1`
+// +kubebuilder:printcolumn:name="Last Run",type=string,JSONPath=`.status.lastRun`
+// +kubebuilder:printcolumn:name="Exit Status",type=string,JSONPath=`.status.exitStatus`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+
+// SyncJob is the Schema for the syncjobs API.
+type SyncJob struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SyncJobSpec   `json:"spec,omitempty"`
+	Status SyncJobStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SyncJobList contains a list of SyncJob.
+type SyncJobList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SyncJob `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&SyncJob{}, &SyncJobList{})
+}
+
+func (syncjob *SyncJob) GetPassword(ctx context.Context, r client.Reader) (string, error) {
+	var secret corev1.Secret
+	if err := r.Get(ctx, types.NamespacedName{Name: syncjob.Spec.PasswordSecret.Name, Namespace: syncjob.Namespace}, &secret); err != nil {
+		return "", err
+	}
+
+	value, ok := secret.Data[syncjob.Spec.PasswordSecret.Key]
+	if !ok {
+		return "", fmt.Errorf("key `%s` not found in secret `%s`", syncjob.Spec.PasswordSecret.Key, secret.Name)
+	}
+
+	return string(value), nil
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
+// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+
+// TLSPolicyMapSpec defines the desired state of TLSPolicyMap.
+// +kubebuilder:validation:XValidation:rule="has(self.mailcow) != has(self.mailcowRef)",message="exactly one of mailcow or mailcowRef must be set"
+type TLSPolicyMapSpec struct {
+	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+
+	// Mailcow is the name of the Mailcow in the same namespace, use mailcowRef to use a ClusterMailcow.
+	Mailcow string `json:"mailcow,omitempty"`
+	// MailcowRef references the Mailcow or ClusterMailcow, instead of mailcow.
+	MailcowRef *MailcowReference `json:"mailcowRef,omitempty"`
+
+	// Dest is the domain or email address the policy applies to.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Dest is immutable"
+	Dest string `json:"dest"`
+
+	// Policy is the TLS security level postfix enforces when delivering to the destination.
+	Policy TLSPolicy `json:"policy"`
+
+	// Parameters are the postfix attributes of the policy, e.g. `match=hostname`, see
+	// http://www.postfix.org/postconf.5.html#smtp_tls_policy_maps.
+	Parameters string `json:"parameters,omitempty"`
+
+	// +kubebuilder:default:=true
+	Active *bool `json:"active,omitempty"`
+
+	// ResyncInterval overrides the resyncInterval of the Mailcow, 0 disables the resync.
+	ResyncInterval *metav1.Duration `json:"resyncInterval,omitempty"`
+
+	// DeletionPolicy overrides the deletionPolicy of the Mailcow.
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
+}
+
+// TLSPolicy is a postfix TLS security level.
+// +kubebuilder:validation:Enum=none;may;encrypt;dane;dane-only;fingerprint;verify;secure
+type TLSPolicy string
+
+const (
+	TLSPolicyNone        TLSPolicy = "none"
+	TLSPolicyMay         TLSPolicy = "may"
+	TLSPolicyEncrypt     TLSPolicy = "encrypt"
+	TLSPolicyDane        TLSPolicy = "dane"
+	TLSPolicyDaneOnly    TLSPolicy = "dane-only"
+	TLSPolicyFingerprint TLSPolicy = "fingerprint"
+	TLSPolicyVerify      TLSPolicy = "verify"
+	TLSPolicySecure      TLSPolicy = "secure"
+)
+
+// TLSPolicyMapStatus defines the observed state of TLSPolicyMap.
+type TLSPolicyMapStatus struct {
+	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+	// +kubebuilder:validation:Enum=Progressing;Ready;Degraded
+	Phase string `json:"phase,omitempty"`
+	// +kubebuilder:validation:Optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// ID is the id of the TLS policy map in mailcow.
+	ID *int `json:"id,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Dest",type=string,JSONPath=`.spec.dest`
+// +kubebuilder:printcolumn:name="Policy",type=string,JSONPath=`.spec.policy`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+
+// TLSPolicyMap is the Schema for the tlspolicymaps API.
+type TLSPolicyMap struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TLSPolicyMapSpec   `json:"spec,omitempty"`
+	Status TLSPolicyMapStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TLSPolicyMapList contains a list of TLSPolicyMap.
+type TLSPolicyMapList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TLSPolicyMap `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&TLSPolicyMap{}, &TLSPolicyMapList{})
+}
+
+// GetMailcowRef returns the reference to the Mailcow or ClusterMailcow of the TLS policy map.
+func (tlsPolicyMap *TLSPolicyMap) GetMailcowRef() MailcowReference {
+	return newMailcowReference(tlsPolicyMap.Spec.Mailcow, tlsPolicyMap.Spec.MailcowRef)
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
+// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+
+// TransportMapSpec defines the desired state of TransportMap.
+// +kubebuilder:validation:XValidation:rule="has(self.mailcow) != has(self.mailcowRef)",message="exactly one of mailcow or mailcowRef must be set"
+// +kubebuilder:validation:XValidation:rule="has(self.username) == has(self.passwordSecret)",message="username and passwordSecret must be set together"
+type TransportMapSpec struct {
+	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+
+	// Mailcow is the name of the Mailcow in the same namespace, use mailcowRef to use a ClusterMailcow.
+	Mailcow string `json:"mailcow,omitempty"`
+	// MailcowRef references the Mailcow or ClusterMailcow, instead of mailcow.
+	MailcowRef *MailcowReference `json:"mailcowRef,omitempty"`
+
+	// Destination is the domain or email address whose mail is routed through the next hop.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Destination is immutable"
+	Destination string `json:"destination"`
+
+	// Nexthop is the smarthost with its port, e.g. [smtp.example.com]:587.
+	Nexthop string `json:"nexthop"`
+
+	// Username authenticates with the next hop, not set relays without authentication.
+	Username string `json:"username,omitempty"`
+	// PasswordSecret holds the password of the username. The password is pushed to mailcow again when the secret changes.
+	PasswordSecret *corev1.SecretKeySelector `json:"passwordSecret,omitempty"`
+
+	// +kubebuilder:default:=true
+	Active *bool `json:"active,omitempty"`
+
+	// ResyncInterval overrides the resyncInterval of the Mailcow, 0 disables the resync.
+	ResyncInterval *metav1.Duration `json:"resyncInterval,omitempty"`
+
+	// DeletionPolicy overrides the deletionPolicy of the Mailcow.
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
+}
+
+// TransportMapStatus defines the observed state of TransportMap.
+type TransportMapStatus struct {
+	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+	// +kubebuilder:validation:Enum=Progressing;Ready;Degraded
+	Phase string `json:"phase,omitempty"`
+	// +kubebuilder:validation:Optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// ID is the id of the transport map in mailcow.
+	ID *int `json:"id,omitempty"`
+	// PasswordHash is a hash of the password last pushed to mailcow, to detect a changed secret.
+	PasswordHash string `json:"passwordHash,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Destination",type=string,JSONPath=`.spec.destination`
+// +kubebuilder:printcolumn:name="Nexthop",type=string,JSONPath=`.spec.nexthop`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+
+// TransportMap is the Schema for the transportmaps API.
+type TransportMap struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TransportMapSpec   `json:"spec,omitempty"`
+	Status TransportMapStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TransportMapList contains a list of TransportMap.
+type TransportMapList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TransportMap `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&TransportMap{}, &TransportMapList{})
+}
+
+// GetMailcowRef returns the reference to the Mailcow or ClusterMailcow of the transport map.
+func (transportMap *TransportMap) GetMailcowRef() MailcowReference {
+	return newMailcowReference(transportMap.Spec.Mailcow, transportMap.Spec.MailcowRef)
+}
+
+// GetPassword returns the password from the referenced secret, or an empty string if no secret is referenced.
+func (transportMap *TransportMap) GetPassword(ctx context.Context, r client.Reader) (string, error) {
+	if transportMap.Spec.PasswordSecret == nil {
+		return "", nil
+	}
+
+	var secret corev1.Secret
+	if err := r.Get(ctx, types.NamespacedName{Name: transportMap.Spec.PasswordSecret.Name, Namespace: transportMap.Namespace}, &secret); err != nil {
+		return "", err
+	}
+
+	value, ok := secret.Data[transportMap.Spec.PasswordSecret.Key]
+	if !ok {
+		return "", fmt.Errorf("key `%s` not found in secret `%s`", transportMap.Spec.PasswordSecret.Key, secret.Name)
+	}
+
+	return string(value), nil
+}
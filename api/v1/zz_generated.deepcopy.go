@@ -21,6 +21,8 @@ limitations under the License.
 package v1
 
 import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -29,8 +31,8 @@ func (in *Alias) DeepCopyInto(out *Alias) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
-	out.Status = in.Status
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Alias.
@@ -86,6 +88,16 @@ func (in *AliasList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AliasSpec) DeepCopyInto(out *AliasSpec) {
 	*out = *in
+	if in.MailcowRef != nil {
+		in, out := &in.MailcowRef, &out.MailcowRef
+		*out = new(MailcowReference)
+		**out = **in
+	}
+	if in.ResyncInterval != nil {
+		in, out := &in.ResyncInterval, &out.ResyncInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AliasSpec.
@@ -101,6 +113,13 @@ func (in *AliasSpec) DeepCopy() *AliasSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AliasStatus) DeepCopyInto(out *AliasStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AliasStatus.
@@ -114,53 +133,51 @@ func (in *AliasStatus) DeepCopy() *AliasStatus {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Domain) DeepCopyInto(out *Domain) {
+func (in *AllowedNamespaces) DeepCopyInto(out *AllowedNamespaces) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	if in.Names != nil {
+		in, out := &in.Names, &out.Names
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Domain.
-func (in *Domain) DeepCopy() *Domain {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AllowedNamespaces.
+func (in *AllowedNamespaces) DeepCopy() *AllowedNamespaces {
 	if in == nil {
 		return nil
 	}
-	out := new(Domain)
+	out := new(AllowedNamespaces)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Domain) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DomainAdmin) DeepCopyInto(out *DomainAdmin) {
+func (in *AppPassword) DeepCopyInto(out *AppPassword) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainAdmin.
-func (in *DomainAdmin) DeepCopy() *DomainAdmin {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppPassword.
+func (in *AppPassword) DeepCopy() *AppPassword {
 	if in == nil {
 		return nil
 	}
-	out := new(DomainAdmin)
+	out := new(AppPassword)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DomainAdmin) DeepCopyObject() runtime.Object {
+func (in *AppPassword) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -168,31 +185,31 @@ func (in *DomainAdmin) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DomainAdminList) DeepCopyInto(out *DomainAdminList) {
+func (in *AppPasswordList) DeepCopyInto(out *AppPasswordList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]DomainAdmin, len(*in))
+		*out = make([]AppPassword, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainAdminList.
-func (in *DomainAdminList) DeepCopy() *DomainAdminList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppPasswordList.
+func (in *AppPasswordList) DeepCopy() *AppPasswordList {
 	if in == nil {
 		return nil
 	}
-	out := new(DomainAdminList)
+	out := new(AppPasswordList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DomainAdminList) DeepCopyObject() runtime.Object {
+func (in *AppPasswordList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -200,72 +217,115 @@ func (in *DomainAdminList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DomainAdminSpec) DeepCopyInto(out *DomainAdminSpec) {
+func (in *AppPasswordSpec) DeepCopyInto(out *AppPasswordSpec) {
 	*out = *in
-	in.PasswordSecret.DeepCopyInto(&out.PasswordSecret)
+	if in.PasswordSecret != nil {
+		in, out := &in.PasswordSecret, &out.PasswordSecret
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Protocols != nil {
+		in, out := &in.Protocols, &out.Protocols
+		*out = make([]AppPasswordProtocol, len(*in))
+		copy(*out, *in)
+	}
 	if in.Active != nil {
 		in, out := &in.Active, &out.Active
 		*out = new(bool)
 		**out = **in
 	}
-	if in.Domains != nil {
-		in, out := &in.Domains, &out.Domains
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppPasswordSpec.
+func (in *AppPasswordSpec) DeepCopy() *AppPasswordSpec {
+	if in == nil {
+		return nil
 	}
+	out := new(AppPasswordSpec)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainAdminSpec.
-func (in *DomainAdminSpec) DeepCopy() *DomainAdminSpec {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppPasswordStatus) DeepCopyInto(out *AppPasswordStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppPasswordStatus.
+func (in *AppPasswordStatus) DeepCopy() *AppPasswordStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(DomainAdminSpec)
+	out := new(AppPasswordStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DomainAdminStatus) DeepCopyInto(out *DomainAdminStatus) {
+func (in *BCCMap) DeepCopyInto(out *BCCMap) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainAdminStatus.
-func (in *DomainAdminStatus) DeepCopy() *DomainAdminStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BCCMap.
+func (in *BCCMap) DeepCopy() *BCCMap {
 	if in == nil {
 		return nil
 	}
-	out := new(DomainAdminStatus)
+	out := new(BCCMap)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BCCMap) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DomainList) DeepCopyInto(out *DomainList) {
+func (in *BCCMapList) DeepCopyInto(out *BCCMapList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Domain, len(*in))
+		*out = make([]BCCMap, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainList.
-func (in *DomainList) DeepCopy() *DomainList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BCCMapList.
+func (in *BCCMapList) DeepCopy() *BCCMapList {
 	if in == nil {
 		return nil
 	}
-	out := new(DomainList)
+	out := new(BCCMapList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DomainList) DeepCopyObject() runtime.Object {
+func (in *BCCMapList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -273,61 +333,88 @@ func (in *DomainList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DomainSpec) DeepCopyInto(out *DomainSpec) {
+func (in *BCCMapSpec) DeepCopyInto(out *BCCMapSpec) {
 	*out = *in
+	if in.MailcowRef != nil {
+		in, out := &in.MailcowRef, &out.MailcowRef
+		*out = new(MailcowReference)
+		**out = **in
+	}
+	if in.LocalDestRef != nil {
+		in, out := &in.LocalDestRef, &out.LocalDestRef
+		*out = new(LocalDestReference)
+		**out = **in
+	}
 	if in.Active != nil {
 		in, out := &in.Active, &out.Active
 		*out = new(bool)
 		**out = **in
 	}
+	if in.ResyncInterval != nil {
+		in, out := &in.ResyncInterval, &out.ResyncInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainSpec.
-func (in *DomainSpec) DeepCopy() *DomainSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BCCMapSpec.
+func (in *BCCMapSpec) DeepCopy() *BCCMapSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(DomainSpec)
+	out := new(BCCMapSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DomainStatus) DeepCopyInto(out *DomainStatus) {
+func (in *BCCMapStatus) DeepCopyInto(out *BCCMapStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(int)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainStatus.
-func (in *DomainStatus) DeepCopy() *DomainStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BCCMapStatus.
+func (in *BCCMapStatus) DeepCopy() *BCCMapStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(DomainStatus)
+	out := new(BCCMapStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Mailbox) DeepCopyInto(out *Mailbox) {
+func (in *ClusterMailcow) DeepCopyInto(out *ClusterMailcow) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Mailbox.
-func (in *Mailbox) DeepCopy() *Mailbox {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterMailcow.
+func (in *ClusterMailcow) DeepCopy() *ClusterMailcow {
 	if in == nil {
 		return nil
 	}
-	out := new(Mailbox)
+	out := new(ClusterMailcow)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Mailbox) DeepCopyObject() runtime.Object {
+func (in *ClusterMailcow) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -335,31 +422,31 @@ func (in *Mailbox) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MailboxList) DeepCopyInto(out *MailboxList) {
+func (in *ClusterMailcowList) DeepCopyInto(out *ClusterMailcowList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Mailbox, len(*in))
+		*out = make([]ClusterMailcow, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MailboxList.
-func (in *MailboxList) DeepCopy() *MailboxList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterMailcowList.
+func (in *ClusterMailcowList) DeepCopy() *ClusterMailcowList {
 	if in == nil {
 		return nil
 	}
-	out := new(MailboxList)
+	out := new(ClusterMailcowList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MailboxList) DeepCopyObject() runtime.Object {
+func (in *ClusterMailcowList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -367,72 +454,89 @@ func (in *MailboxList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MailboxSpec) DeepCopyInto(out *MailboxSpec) {
+func (in *ClusterMailcowSpec) DeepCopyInto(out *ClusterMailcowSpec) {
 	*out = *in
-	in.PasswordSecret.DeepCopyInto(&out.PasswordSecret)
-	if in.Active != nil {
-		in, out := &in.Active, &out.Active
-		*out = new(bool)
-		**out = **in
+	in.MailcowSpec.DeepCopyInto(&out.MailcowSpec)
+	if in.AllowedNamespaces != nil {
+		in, out := &in.AllowedNamespaces, &out.AllowedNamespaces
+		*out = new(AllowedNamespaces)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.ForcePasswordChange != nil {
-		in, out := &in.ForcePasswordChange, &out.ForcePasswordChange
-		*out = new(bool)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterMailcowSpec.
+func (in *ClusterMailcowSpec) DeepCopy() *ClusterMailcowSpec {
+	if in == nil {
+		return nil
 	}
-	if in.Quota != nil {
-		in, out := &in.Quota, &out.Quota
-		*out = new(int64)
-		**out = **in
+	out := new(ClusterMailcowSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DKIMStatus) DeepCopyInto(out *DKIMStatus) {
+	*out = *in
+	if in.LastRotation != nil {
+		in, out := &in.LastRotation, &out.LastRotation
+		*out = (*in).DeepCopy()
+	}
+	if in.PendingSince != nil {
+		in, out := &in.PendingSince, &out.PendingSince
+		*out = (*in).DeepCopy()
+	}
+	if in.PreviousUntil != nil {
+		in, out := &in.PreviousUntil, &out.PreviousUntil
+		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MailboxSpec.
-func (in *MailboxSpec) DeepCopy() *MailboxSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DKIMStatus.
+func (in *DKIMStatus) DeepCopy() *DKIMStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(MailboxSpec)
+	out := new(DKIMStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MailboxStatus) DeepCopyInto(out *MailboxStatus) {
+func (in *DNSRecord) DeepCopyInto(out *DNSRecord) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MailboxStatus.
-func (in *MailboxStatus) DeepCopy() *MailboxStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSRecord.
+func (in *DNSRecord) DeepCopy() *DNSRecord {
 	if in == nil {
 		return nil
 	}
-	out := new(MailboxStatus)
+	out := new(DNSRecord)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Mailcow) DeepCopyInto(out *Mailcow) {
+func (in *Domain) DeepCopyInto(out *Domain) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Mailcow.
-func (in *Mailcow) DeepCopy() *Mailcow {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Domain.
+func (in *Domain) DeepCopy() *Domain {
 	if in == nil {
 		return nil
 	}
-	out := new(Mailcow)
+	out := new(Domain)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Mailcow) DeepCopyObject() runtime.Object {
+func (in *Domain) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -440,31 +544,58 @@ func (in *Mailcow) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MailcowList) DeepCopyInto(out *MailcowList) {
+func (in *DomainAdmin) DeepCopyInto(out *DomainAdmin) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainAdmin.
+func (in *DomainAdmin) DeepCopy() *DomainAdmin {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainAdmin)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DomainAdmin) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainAdminList) DeepCopyInto(out *DomainAdminList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Mailcow, len(*in))
+		*out = make([]DomainAdmin, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MailcowList.
-func (in *MailcowList) DeepCopy() *MailcowList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainAdminList.
+func (in *DomainAdminList) DeepCopy() *DomainAdminList {
 	if in == nil {
 		return nil
 	}
-	out := new(MailcowList)
+	out := new(DomainAdminList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MailcowList) DeepCopyObject() runtime.Object {
+func (in *DomainAdminList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -472,32 +603,1528 @@ func (in *MailcowList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MailcowSpec) DeepCopyInto(out *MailcowSpec) {
+func (in *DomainAdminSpec) DeepCopyInto(out *DomainAdminSpec) {
 	*out = *in
-	in.Secret.DeepCopyInto(&out.Secret)
+	if in.MailcowRef != nil {
+		in, out := &in.MailcowRef, &out.MailcowRef
+		*out = new(MailcowReference)
+		**out = **in
+	}
+	in.PasswordSecret.DeepCopyInto(&out.PasswordSecret)
+	if in.GeneratePassword != nil {
+		in, out := &in.GeneratePassword, &out.GeneratePassword
+		*out = new(GeneratePassword)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Active != nil {
+		in, out := &in.Active, &out.Active
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Domains != nil {
+		in, out := &in.Domains, &out.Domains
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ResyncInterval != nil {
+		in, out := &in.ResyncInterval, &out.ResyncInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MailcowSpec.
-func (in *MailcowSpec) DeepCopy() *MailcowSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainAdminSpec.
+func (in *DomainAdminSpec) DeepCopy() *DomainAdminSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(MailcowSpec)
+	out := new(DomainAdminSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MailcowStatus) DeepCopyInto(out *MailcowStatus) {
+func (in *DomainAdminStatus) DeepCopyInto(out *DomainAdminStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MailcowStatus.
-func (in *MailcowStatus) DeepCopy() *MailcowStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainAdminStatus.
+func (in *DomainAdminStatus) DeepCopy() *DomainAdminStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(MailcowStatus)
+	out := new(DomainAdminStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainClaimedError) DeepCopyInto(out *DomainClaimedError) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainClaimedError.
+func (in *DomainClaimedError) DeepCopy() *DomainClaimedError {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainClaimedError)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainDKIM) DeepCopyInto(out *DomainDKIM) {
+	*out = *in
+	if in.RotationPeriod != nil {
+		in, out := &in.RotationPeriod, &out.RotationPeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.RotationOverlap != nil {
+		in, out := &in.RotationOverlap, &out.RotationOverlap
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainDKIM.
+func (in *DomainDKIM) DeepCopy() *DomainDKIM {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainDKIM)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainDNS) DeepCopyInto(out *DomainDNS) {
+	*out = *in
+	if in.SPFIncludes != nil {
+		in, out := &in.SPFIncludes, &out.SPFIncludes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DMARCRua != nil {
+		in, out := &in.DMARCRua, &out.DMARCRua
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DMARCRuf != nil {
+		in, out := &in.DMARCRuf, &out.DMARCRuf
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExternalDNS != nil {
+		in, out := &in.ExternalDNS, &out.ExternalDNS
+		*out = new(ExternalDNS)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainDNS.
+func (in *DomainDNS) DeepCopy() *DomainDNS {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainDNS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainList) DeepCopyInto(out *DomainList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Domain, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainList.
+func (in *DomainList) DeepCopy() *DomainList {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DomainList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainNotAllowedError) DeepCopyInto(out *DomainNotAllowedError) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainNotAllowedError.
+func (in *DomainNotAllowedError) DeepCopy() *DomainNotAllowedError {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainNotAllowedError)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainPolicy) DeepCopyInto(out *DomainPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainPolicy.
+func (in *DomainPolicy) DeepCopy() *DomainPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DomainPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainPolicyList) DeepCopyInto(out *DomainPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DomainPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainPolicyList.
+func (in *DomainPolicyList) DeepCopy() *DomainPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DomainPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainPolicySpec) DeepCopyInto(out *DomainPolicySpec) {
+	*out = *in
+	if in.MailcowRef != nil {
+		in, out := &in.MailcowRef, &out.MailcowRef
+		*out = new(MailcowReference)
+		**out = **in
+	}
+	if in.Allow != nil {
+		in, out := &in.Allow, &out.Allow
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Deny != nil {
+		in, out := &in.Deny, &out.Deny
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ResyncInterval != nil {
+		in, out := &in.ResyncInterval, &out.ResyncInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainPolicySpec.
+func (in *DomainPolicySpec) DeepCopy() *DomainPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainPolicyStatus) DeepCopyInto(out *DomainPolicyStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainPolicyStatus.
+func (in *DomainPolicyStatus) DeepCopy() *DomainPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainSpec) DeepCopyInto(out *DomainSpec) {
+	*out = *in
+	if in.MailcowRef != nil {
+		in, out := &in.MailcowRef, &out.MailcowRef
+		*out = new(MailcowReference)
+		**out = **in
+	}
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = new(int)
+		**out = **in
+	}
+	if in.Active != nil {
+		in, out := &in.Active, &out.Active
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ResyncInterval != nil {
+		in, out := &in.ResyncInterval, &out.ResyncInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.DKIM != nil {
+		in, out := &in.DKIM, &out.DKIM
+		*out = new(DomainDKIM)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DNS != nil {
+		in, out := &in.DNS, &out.DNS
+		*out = new(DomainDNS)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SharedWith != nil {
+		in, out := &in.SharedWith, &out.SharedWith
+		*out = new(AllowedNamespaces)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainSpec.
+func (in *DomainSpec) DeepCopy() *DomainSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainStatus) DeepCopyInto(out *DomainStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DKIM != nil {
+		in, out := &in.DKIM, &out.DKIM
+		*out = new(DKIMStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DNSRecords != nil {
+		in, out := &in.DNSRecords, &out.DNSRecords
+		*out = make([]DNSRecord, len(*in))
+		copy(*out, *in)
+	}
+	if in.RelayHostID != nil {
+		in, out := &in.RelayHostID, &out.RelayHostID
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainStatus.
+func (in *DomainStatus) DeepCopy() *DomainStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalDNS) DeepCopyInto(out *ExternalDNS) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalDNS.
+func (in *ExternalDNS) DeepCopy() *ExternalDNS {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalDNS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GeneratePassword) DeepCopyInto(out *GeneratePassword) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GeneratePassword.
+func (in *GeneratePassword) DeepCopy() *GeneratePassword {
+	if in == nil {
+		return nil
+	}
+	out := new(GeneratePassword)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalDestReference) DeepCopyInto(out *LocalDestReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalDestReference.
+func (in *LocalDestReference) DeepCopy() *LocalDestReference {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalDestReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Mailbox) DeepCopyInto(out *Mailbox) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Mailbox.
+func (in *Mailbox) DeepCopy() *Mailbox {
+	if in == nil {
+		return nil
+	}
+	out := new(Mailbox)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Mailbox) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MailboxList) DeepCopyInto(out *MailboxList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Mailbox, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MailboxList.
+func (in *MailboxList) DeepCopy() *MailboxList {
+	if in == nil {
+		return nil
+	}
+	out := new(MailboxList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MailboxList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MailboxSpec) DeepCopyInto(out *MailboxSpec) {
+	*out = *in
+	if in.MailcowRef != nil {
+		in, out := &in.MailcowRef, &out.MailcowRef
+		*out = new(MailcowReference)
+		**out = **in
+	}
+	in.PasswordSecret.DeepCopyInto(&out.PasswordSecret)
+	if in.GeneratePassword != nil {
+		in, out := &in.GeneratePassword, &out.GeneratePassword
+		*out = new(GeneratePassword)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Active != nil {
+		in, out := &in.Active, &out.Active
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ForcePasswordChange != nil {
+		in, out := &in.ForcePasswordChange, &out.ForcePasswordChange
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Quota != nil {
+		in, out := &in.Quota, &out.Quota
+		*out = new(int64)
+		**out = **in
+	}
+	if in.SogoAccess != nil {
+		in, out := &in.SogoAccess, &out.SogoAccess
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SenderACL != nil {
+		in, out := &in.SenderACL, &out.SenderACL
+		*out = new([]string)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make([]string, len(*in))
+			copy(*out, *in)
+		}
+	}
+	if in.ResyncInterval != nil {
+		in, out := &in.ResyncInterval, &out.ResyncInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Usage != nil {
+		in, out := &in.Usage, &out.Usage
+		*out = new(MailboxUsage)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MailboxSpec.
+func (in *MailboxSpec) DeepCopy() *MailboxSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MailboxSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MailboxStatus) DeepCopyInto(out *MailboxStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Quota != nil {
+		in, out := &in.Quota, &out.Quota
+		*out = new(int64)
+		**out = **in
+	}
+	if in.QuotaUsed != nil {
+		in, out := &in.QuotaUsed, &out.QuotaUsed
+		*out = new(int64)
+		**out = **in
+	}
+	if in.QuotaPercent != nil {
+		in, out := &in.QuotaPercent, &out.QuotaPercent
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Messages != nil {
+		in, out := &in.Messages, &out.Messages
+		*out = new(int64)
+		**out = **in
+	}
+	if in.LastIMAPLogin != nil {
+		in, out := &in.LastIMAPLogin, &out.LastIMAPLogin
+		*out = (*in).DeepCopy()
+	}
+	if in.LastPOP3Login != nil {
+		in, out := &in.LastPOP3Login, &out.LastPOP3Login
+		*out = (*in).DeepCopy()
+	}
+	if in.LastSMTPLogin != nil {
+		in, out := &in.LastSMTPLogin, &out.LastSMTPLogin
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MailboxStatus.
+func (in *MailboxStatus) DeepCopy() *MailboxStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MailboxStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MailboxUsage) DeepCopyInto(out *MailboxUsage) {
+	*out = *in
+	if in.RefreshInterval != nil {
+		in, out := &in.RefreshInterval, &out.RefreshInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MailboxUsage.
+func (in *MailboxUsage) DeepCopy() *MailboxUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(MailboxUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Mailcow) DeepCopyInto(out *Mailcow) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Mailcow.
+func (in *Mailcow) DeepCopy() *Mailcow {
+	if in == nil {
+		return nil
+	}
+	out := new(Mailcow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Mailcow) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MailcowCABundle) DeepCopyInto(out *MailcowCABundle) {
+	*out = *in
+	if in.ConfigMap != nil {
+		in, out := &in.ConfigMap, &out.ConfigMap
+		*out = new(corev1.ConfigMapKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Secret != nil {
+		in, out := &in.Secret, &out.Secret
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MailcowCABundle.
+func (in *MailcowCABundle) DeepCopy() *MailcowCABundle {
+	if in == nil {
+		return nil
+	}
+	out := new(MailcowCABundle)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MailcowContainerStatus) DeepCopyInto(out *MailcowContainerStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MailcowContainerStatus.
+func (in *MailcowContainerStatus) DeepCopy() *MailcowContainerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MailcowContainerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MailcowList) DeepCopyInto(out *MailcowList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Mailcow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MailcowList.
+func (in *MailcowList) DeepCopy() *MailcowList {
+	if in == nil {
+		return nil
+	}
+	out := new(MailcowList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MailcowList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MailcowRateLimit) DeepCopyInto(out *MailcowRateLimit) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MailcowRateLimit.
+func (in *MailcowRateLimit) DeepCopy() *MailcowRateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(MailcowRateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MailcowReference) DeepCopyInto(out *MailcowReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MailcowReference.
+func (in *MailcowReference) DeepCopy() *MailcowReference {
+	if in == nil {
+		return nil
+	}
+	out := new(MailcowReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MailcowSolrStatus) DeepCopyInto(out *MailcowSolrStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MailcowSolrStatus.
+func (in *MailcowSolrStatus) DeepCopy() *MailcowSolrStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MailcowSolrStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MailcowSpec) DeepCopyInto(out *MailcowSpec) {
+	*out = *in
+	in.Secret.DeepCopyInto(&out.Secret)
+	if in.HealthCheckInterval != nil {
+		in, out := &in.HealthCheckInterval, &out.HealthCheckInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.ResyncInterval != nil {
+		in, out := &in.ResyncInterval, &out.ResyncInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = new(MailcowRateLimit)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(MailcowTLS)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MailcowSpec.
+func (in *MailcowSpec) DeepCopy() *MailcowSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MailcowSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MailcowStatus) DeepCopyInto(out *MailcowStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Containers != nil {
+		in, out := &in.Containers, &out.Containers
+		*out = make([]MailcowContainerStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Vmail != nil {
+		in, out := &in.Vmail, &out.Vmail
+		*out = new(MailcowVmailStatus)
+		**out = **in
+	}
+	if in.Solr != nil {
+		in, out := &in.Solr, &out.Solr
+		*out = new(MailcowSolrStatus)
+		**out = **in
+	}
+	if in.LastHealthCheck != nil {
+		in, out := &in.LastHealthCheck, &out.LastHealthCheck
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MailcowStatus.
+func (in *MailcowStatus) DeepCopy() *MailcowStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MailcowStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MailcowTLS) DeepCopyInto(out *MailcowTLS) {
+	*out = *in
+	if in.CA != nil {
+		in, out := &in.CA, &out.CA
+		*out = new(MailcowCABundle)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClientCertificate != nil {
+		in, out := &in.ClientCertificate, &out.ClientCertificate
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MailcowTLS.
+func (in *MailcowTLS) DeepCopy() *MailcowTLS {
+	if in == nil {
+		return nil
+	}
+	out := new(MailcowTLS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MailcowVmailStatus) DeepCopyInto(out *MailcowVmailStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MailcowVmailStatus.
+func (in *MailcowVmailStatus) DeepCopy() *MailcowVmailStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MailcowVmailStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RecipientMap) DeepCopyInto(out *RecipientMap) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecipientMap.
+func (in *RecipientMap) DeepCopy() *RecipientMap {
+	if in == nil {
+		return nil
+	}
+	out := new(RecipientMap)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RecipientMap) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RecipientMapList) DeepCopyInto(out *RecipientMapList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RecipientMap, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecipientMapList.
+func (in *RecipientMapList) DeepCopy() *RecipientMapList {
+	if in == nil {
+		return nil
+	}
+	out := new(RecipientMapList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RecipientMapList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RecipientMapSpec) DeepCopyInto(out *RecipientMapSpec) {
+	*out = *in
+	if in.MailcowRef != nil {
+		in, out := &in.MailcowRef, &out.MailcowRef
+		*out = new(MailcowReference)
+		**out = **in
+	}
+	if in.Active != nil {
+		in, out := &in.Active, &out.Active
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ResyncInterval != nil {
+		in, out := &in.ResyncInterval, &out.ResyncInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecipientMapSpec.
+func (in *RecipientMapSpec) DeepCopy() *RecipientMapSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RecipientMapSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RecipientMapStatus) DeepCopyInto(out *RecipientMapStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecipientMapStatus.
+func (in *RecipientMapStatus) DeepCopy() *RecipientMapStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RecipientMapStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RelayHost) DeepCopyInto(out *RelayHost) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RelayHost.
+func (in *RelayHost) DeepCopy() *RelayHost {
+	if in == nil {
+		return nil
+	}
+	out := new(RelayHost)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RelayHost) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RelayHostList) DeepCopyInto(out *RelayHostList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RelayHost, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RelayHostList.
+func (in *RelayHostList) DeepCopy() *RelayHostList {
+	if in == nil {
+		return nil
+	}
+	out := new(RelayHostList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RelayHostList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RelayHostSpec) DeepCopyInto(out *RelayHostSpec) {
+	*out = *in
+	if in.MailcowRef != nil {
+		in, out := &in.MailcowRef, &out.MailcowRef
+		*out = new(MailcowReference)
+		**out = **in
+	}
+	if in.PasswordSecret != nil {
+		in, out := &in.PasswordSecret, &out.PasswordSecret
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Active != nil {
+		in, out := &in.Active, &out.Active
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ResyncInterval != nil {
+		in, out := &in.ResyncInterval, &out.ResyncInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RelayHostSpec.
+func (in *RelayHostSpec) DeepCopy() *RelayHostSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RelayHostSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RelayHostStatus) DeepCopyInto(out *RelayHostStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RelayHostStatus.
+func (in *RelayHostStatus) DeepCopy() *RelayHostStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RelayHostStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncJob) DeepCopyInto(out *SyncJob) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncJob.
+func (in *SyncJob) DeepCopy() *SyncJob {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncJob)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SyncJob) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncJobList) DeepCopyInto(out *SyncJobList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SyncJob, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncJobList.
+func (in *SyncJobList) DeepCopy() *SyncJobList {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncJobList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SyncJobList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncJobSpec) DeepCopyInto(out *SyncJobSpec) {
+	*out = *in
+	in.PasswordSecret.DeepCopyInto(&out.PasswordSecret)
+	if in.Delete2Duplicates != nil {
+		in, out := &in.Delete2Duplicates, &out.Delete2Duplicates
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Delete1 != nil {
+		in, out := &in.Delete1, &out.Delete1
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Delete2 != nil {
+		in, out := &in.Delete2, &out.Delete2
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Automap != nil {
+		in, out := &in.Automap, &out.Automap
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SkipCrossDuplicates != nil {
+		in, out := &in.SkipCrossDuplicates, &out.SkipCrossDuplicates
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SubscribeAll != nil {
+		in, out := &in.SubscribeAll, &out.SubscribeAll
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Active != nil {
+		in, out := &in.Active, &out.Active
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncJobSpec.
+func (in *SyncJobSpec) DeepCopy() *SyncJobSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncJobSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncJobStatus) DeepCopyInto(out *SyncJobStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(int)
+		**out = **in
+	}
+	if in.Success != nil {
+		in, out := &in.Success, &out.Success
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncJobStatus.
+func (in *SyncJobStatus) DeepCopy() *SyncJobStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncJobStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TLSPolicyMap) DeepCopyInto(out *TLSPolicyMap) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSPolicyMap.
+func (in *TLSPolicyMap) DeepCopy() *TLSPolicyMap {
+	if in == nil {
+		return nil
+	}
+	out := new(TLSPolicyMap)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TLSPolicyMap) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TLSPolicyMapList) DeepCopyInto(out *TLSPolicyMapList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TLSPolicyMap, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSPolicyMapList.
+func (in *TLSPolicyMapList) DeepCopy() *TLSPolicyMapList {
+	if in == nil {
+		return nil
+	}
+	out := new(TLSPolicyMapList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TLSPolicyMapList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TLSPolicyMapSpec) DeepCopyInto(out *TLSPolicyMapSpec) {
+	*out = *in
+	if in.MailcowRef != nil {
+		in, out := &in.MailcowRef, &out.MailcowRef
+		*out = new(MailcowReference)
+		**out = **in
+	}
+	if in.Active != nil {
+		in, out := &in.Active, &out.Active
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ResyncInterval != nil {
+		in, out := &in.ResyncInterval, &out.ResyncInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSPolicyMapSpec.
+func (in *TLSPolicyMapSpec) DeepCopy() *TLSPolicyMapSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TLSPolicyMapSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TLSPolicyMapStatus) DeepCopyInto(out *TLSPolicyMapStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSPolicyMapStatus.
+func (in *TLSPolicyMapStatus) DeepCopy() *TLSPolicyMapStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TLSPolicyMapStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransportMap) DeepCopyInto(out *TransportMap) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransportMap.
+func (in *TransportMap) DeepCopy() *TransportMap {
+	if in == nil {
+		return nil
+	}
+	out := new(TransportMap)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TransportMap) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransportMapList) DeepCopyInto(out *TransportMapList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TransportMap, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransportMapList.
+func (in *TransportMapList) DeepCopy() *TransportMapList {
+	if in == nil {
+		return nil
+	}
+	out := new(TransportMapList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TransportMapList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransportMapSpec) DeepCopyInto(out *TransportMapSpec) {
+	*out = *in
+	if in.MailcowRef != nil {
+		in, out := &in.MailcowRef, &out.MailcowRef
+		*out = new(MailcowReference)
+		**out = **in
+	}
+	if in.PasswordSecret != nil {
+		in, out := &in.PasswordSecret, &out.PasswordSecret
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Active != nil {
+		in, out := &in.Active, &out.Active
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ResyncInterval != nil {
+		in, out := &in.ResyncInterval, &out.ResyncInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransportMapSpec.
+func (in *TransportMapSpec) DeepCopy() *TransportMapSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TransportMapSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransportMapStatus) DeepCopyInto(out *TransportMapStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransportMapStatus.
+func (in *TransportMapStatus) DeepCopy() *TransportMapStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TransportMapStatus)
 	in.DeepCopyInto(out)
 	return out
 }
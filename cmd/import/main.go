@@ -0,0 +1,330 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Command import lists the objects of an existing mailcow instance and writes them as
+// Domain, Mailbox, Alias and DomainAdmin resources, so they can be adopted by the operator.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	mailcowv1 "github.com/tarteo/mailcow-operator/api/v1"
+	constants "github.com/tarteo/mailcow-operator/common"
+	"github.com/tarteo/mailcow-operator/mailcow"
+)
+
+// passwordPlaceholder is written into the generated password secrets.
+// Passwords of adopted objects are only pushed to mailcow once the secret is changed.
+const passwordPlaceholder = "REPLACE_ME"
+
+const bytesPerMegabyte = 1024 * 1024
+
+var (
+	scheme          = runtime.NewScheme()
+	invalidNameChar = regexp.MustCompile(`[^a-z0-9-]+`)
+)
+
+func init() {
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+
+	utilruntime.Must(mailcowv1.AddToScheme(scheme))
+}
+
+func main() {
+	var namespace string
+	var mailcowName string
+	var mailcowKind string
+	var endpoint string
+	var output string
+	var deletionPolicy string
+	flag.StringVar(&namespace, "namespace", "default", "The namespace of the Mailcow resource and the generated resources.")
+	flag.StringVar(&mailcowName, "mailcow", "", "The name of the Mailcow resource the generated resources refer to.")
+	flag.StringVar(&mailcowKind, "kind", mailcowv1.MailcowKind, "The kind of the resource named by -mailcow, Mailcow or ClusterMailcow.")
+	flag.StringVar(&endpoint, "endpoint", "", "The mailcow endpoint, by default the endpoint and API key are taken from the Mailcow resource "+
+		"in the cluster. When set, the API key is read from the MAILCOW_API_KEY environment variable.")
+	flag.StringVar(&output, "output", "-", "The file the resources are written to, - writes to stdout.")
+	flag.StringVar(&deletionPolicy, "deletion-policy", string(mailcowv1.DeletionPolicyRetain), "The deletionPolicy of the generated resources.")
+	flag.Parse()
+
+	if mailcowName == "" {
+		fail(fmt.Errorf("-mailcow is required"))
+	}
+	if mailcowKind != mailcowv1.MailcowKind && mailcowKind != mailcowv1.ClusterMailcowKind {
+		fail(fmt.Errorf("-kind must be %s or %s", mailcowv1.MailcowKind, mailcowv1.ClusterMailcowKind))
+	}
+	ref := mailcowv1.MailcowReference{Kind: mailcowKind, Name: mailcowName}
+
+	ctx := context.Background()
+	mailcowClient, err := getClient(ctx, namespace, ref, endpoint)
+	if err != nil {
+		fail(fmt.Errorf("unable to create mailcow client: %w", err))
+	}
+
+	objects, err := importObjects(ctx, mailcowClient, namespace, ref, mailcowv1.DeletionPolicy(deletionPolicy))
+	if err != nil {
+		fail(err)
+	}
+
+	var out io.Writer = os.Stdout
+	if output != "-" {
+		file, err := os.Create(output)
+		if err != nil {
+			fail(err)
+		}
+		defer file.Close()
+		out = file
+	}
+
+	if err := writeObjects(out, objects); err != nil {
+		fail(err)
+	}
+	fmt.Fprintf(os.Stderr, "imported %d resources\n", len(objects))
+}
+
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, err)
+	os.Exit(1)
+}
+
+// getClient connects to mailcow directly when an endpoint is given, otherwise through the Mailcow or ClusterMailcow
+// resource in the cluster.
+func getClient(ctx context.Context, namespace string, ref mailcowv1.MailcowReference, endpoint string) (*mailcow.ClientWithResponses, error) {
+	if endpoint != "" {
+		apiKey := os.Getenv("MAILCOW_API_KEY")
+		if apiKey == "" {
+			return nil, fmt.Errorf("MAILCOW_API_KEY is required when -endpoint is set")
+		}
+		return mailcow.NewCustomClientWithResponses(endpoint, apiKey)
+	}
+
+	config, err := ctrl.GetConfig()
+	if err != nil {
+		return nil, err
+	}
+	k8sClient, err := client.New(config, client.Options{Scheme: scheme})
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := mailcowv1.GetMailcow(ctx, k8sClient, namespace, ref)
+	if err != nil {
+		return nil, err
+	}
+	return res.GetClient(ctx, k8sClient)
+}
+
+// importObjects lists the domains, mailboxes, aliases and domain admins in mailcow and converts them to resources.
+func importObjects(ctx context.Context, c *mailcow.ClientWithResponses, namespace string, ref mailcowv1.MailcowReference, deletionPolicy mailcowv1.DeletionPolicy) ([]client.Object, error) {
+	var objects []client.Object
+	// A Mailcow is referenced by name, a ClusterMailcow through mailcowRef
+	mailcowName, mailcowRef := ref.Name, (*mailcowv1.MailcowReference)(nil)
+	if ref.Kind == mailcowv1.ClusterMailcowKind {
+		mailcowName, mailcowRef = "", &ref
+	}
+	objectMeta := func(name string) metav1.ObjectMeta {
+		return metav1.ObjectMeta{
+			Name:        resourceName(name),
+			Namespace:   namespace,
+			Annotations: map[string]string{constants.AnnotationAdopt: "true"},
+		}
+	}
+
+	domains, err := c.ListDomains(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list domains: %w", err)
+	}
+	for _, domain := range domains {
+		if domain.DomainName == nil {
+			continue
+		}
+		objects = append(objects, &mailcowv1.Domain{
+			TypeMeta:   metav1.TypeMeta{APIVersion: mailcowv1.GroupVersion.String(), Kind: "Domain"},
+			ObjectMeta: objectMeta(*domain.DomainName),
+			Spec: mailcowv1.DomainSpec{
+				Mailcow:        mailcowName,
+				MailcowRef:     mailcowRef,
+				Domain:         *domain.DomainName,
+				Description:    value(domain.Description),
+				Quota:          megabytes(domain.MaxQuotaForDomain),
+				MaxQuota:       megabytes(domain.MaxQuotaForMbox),
+				DefQuota:       megabytes(domain.DefQuotaForMbox),
+				MaxMailboxes:   int64(value(domain.MaxNumMboxesForDomain)),
+				Active:         active(domain.Active),
+				DeletionPolicy: deletionPolicy,
+			},
+		})
+	}
+
+	mailboxes, err := c.ListMailboxes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list mailboxes: %w", err)
+	}
+	for _, mailbox := range mailboxes {
+		if mailbox.Username == nil || mailbox.Domain == nil || mailbox.LocalPart == nil {
+			continue
+		}
+		secret := passwordSecret(namespace, *mailbox.Username)
+		quota := megabytes(mailbox.Quota)
+		spec := mailcowv1.MailboxSpec{
+			Mailcow:        mailcowName,
+			MailcowRef:     mailcowRef,
+			Domain:         *mailbox.Domain,
+			LocalPart:      *mailbox.LocalPart,
+			Name:           value(mailbox.Name),
+			PasswordSecret: corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: secret.Name}, Key: "password"},
+			Active:         active(mailbox.Active),
+			Quota:          &quota,
+			DeletionPolicy: deletionPolicy,
+		}
+		if mailbox.Attributes != nil && mailbox.Attributes.SogoAccess != nil {
+			sogoAccess := *mailbox.Attributes.SogoAccess != "0"
+			spec.SogoAccess = &sogoAccess
+		}
+		objects = append(objects, secret, &mailcowv1.Mailbox{
+			TypeMeta:   metav1.TypeMeta{APIVersion: mailcowv1.GroupVersion.String(), Kind: "Mailbox"},
+			ObjectMeta: objectMeta(*mailbox.Username),
+			Spec:       spec,
+		})
+	}
+
+	aliases, err := c.ListAliases(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list aliases: %w", err)
+	}
+	for _, alias := range aliases {
+		// Skip the aliases mailcow keeps for the mailboxes themselves
+		if alias.Address == nil || alias.Goto == nil || *alias.Address == *alias.Goto {
+			continue
+		}
+		objects = append(objects, &mailcowv1.Alias{
+			TypeMeta:   metav1.TypeMeta{APIVersion: mailcowv1.GroupVersion.String(), Kind: "Alias"},
+			ObjectMeta: objectMeta("alias-" + *alias.Address),
+			Spec: mailcowv1.AliasSpec{
+				Mailcow:        mailcowName,
+				MailcowRef:     mailcowRef,
+				Address:        *alias.Address,
+				GoTo:           *alias.Goto,
+				Active:         value(alias.Active) != 0,
+				DeletionPolicy: deletionPolicy,
+			},
+		})
+	}
+
+	domainadmins, err := c.ListDomainAdmins(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list domain admins: %w", err)
+	}
+	for _, domainadmin := range domainadmins {
+		if domainadmin.Username == nil {
+			continue
+		}
+		secret := passwordSecret(namespace, "domainadmin-"+*domainadmin.Username)
+		var domains []string
+		if domainadmin.SelectedDomains != nil {
+			domains = *domainadmin.SelectedDomains
+		}
+		objects = append(objects, secret, &mailcowv1.DomainAdmin{
+			TypeMeta:   metav1.TypeMeta{APIVersion: mailcowv1.GroupVersion.String(), Kind: "DomainAdmin"},
+			ObjectMeta: objectMeta("domainadmin-" + *domainadmin.Username),
+			Spec: mailcowv1.DomainAdminSpec{
+				Mailcow:        mailcowName,
+				MailcowRef:     mailcowRef,
+				Username:       *domainadmin.Username,
+				PasswordSecret: corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: secret.Name}, Key: "password"},
+				Active:         active(domainadmin.Active),
+				Domains:        domains,
+				DeletionPolicy: deletionPolicy,
+			},
+		})
+	}
+
+	return objects, nil
+}
+
+// passwordSecret returns a placeholder secret for the password of a mailbox or domain admin.
+func passwordSecret(namespace, name string) *corev1.Secret {
+	return &corev1.Secret{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+		ObjectMeta: metav1.ObjectMeta{Name: resourceName(name + "-password"), Namespace: namespace},
+		StringData: map[string]string{"password": passwordPlaceholder},
+	}
+}
+
+// writeObjects writes the objects as a multi document yaml, without status and other server side fields.
+func writeObjects(out io.Writer, objects []client.Object) error {
+	for _, obj := range objects {
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		if err != nil {
+			return err
+		}
+		unstructured.RemoveNestedField(content, "status")
+		unstructured.RemoveNestedField(content, "metadata", "creationTimestamp")
+
+		data, err := yaml.Marshal(content)
+		if err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintf(out, "---\n%s", data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resourceName converts a domain or email address into a valid resource name, e.g. info@example.com becomes info-example-com.
+func resourceName(name string) string {
+	name = invalidNameChar.ReplaceAllString(strings.ToLower(name), "-")
+	if len(name) > 253 {
+		name = name[:253]
+	}
+	return strings.Trim(name, "-")
+}
+
+func value[T any](v *T) T {
+	var zero T
+	if v == nil {
+		return zero
+	}
+	return *v
+}
+
+func active(v *int) *bool {
+	if v == nil {
+		return nil
+	}
+	b := *v != 0
+	return &b
+}
+
+func megabytes(v *int) int64 {
+	return int64(value(v)) / bytesPerMegabyte
+}
@@ -31,12 +31,14 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/filters"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	mailcowv1 "github.com/tarteo/mailcow-operator/api/v1"
 	"github.com/tarteo/mailcow-operator/internal/controller"
+	webhookmailcowv1 "github.com/tarteo/mailcow-operator/internal/webhook/v1"
 	// +kubebuilder:scaffold:imports
 )
 
@@ -142,34 +144,164 @@ func main() {
 		os.Exit(1)
 	}
 
+	// The reconcilers share one client per Mailcow resource
+	clients := controller.NewMailcowClients()
+
+	// Export the number of managed resources by phase, next to the metrics of the mailcow API calls
+	metrics.Registry.MustRegister(controller.NewManagedObjectsCollector(mgr.GetClient()))
+
 	if err = (&controller.DomainReconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Clients:  clients,
+		Recorder: mgr.GetEventRecorderFor("domain-controller"),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Domain")
 		os.Exit(1)
 	}
 	if err = (&controller.MailboxReconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Clients:  clients,
+		Recorder: mgr.GetEventRecorderFor("mailbox-controller"),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Mailbox")
 		os.Exit(1)
 	}
 	if err = (&controller.DomainAdminReconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Clients:  clients,
+		Recorder: mgr.GetEventRecorderFor("domainadmin-controller"),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "DomainAdmin")
 		os.Exit(1)
 	}
 	if err = (&controller.AliasReconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Clients:  clients,
+		Recorder: mgr.GetEventRecorderFor("alias-controller"),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Alias")
 		os.Exit(1)
 	}
+	if err = (&controller.BCCMapReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Clients:  clients,
+		Recorder: mgr.GetEventRecorderFor("bccmap-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "BCCMap")
+		os.Exit(1)
+	}
+	if err = (&controller.RecipientMapReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Clients:  clients,
+		Recorder: mgr.GetEventRecorderFor("recipientmap-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "RecipientMap")
+		os.Exit(1)
+	}
+	if err = (&controller.TLSPolicyMapReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Clients:  clients,
+		Recorder: mgr.GetEventRecorderFor("tlspolicymap-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "TLSPolicyMap")
+		os.Exit(1)
+	}
+	if err = (&controller.RelayHostReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Clients:  clients,
+		Recorder: mgr.GetEventRecorderFor("relayhost-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "RelayHost")
+		os.Exit(1)
+	}
+	if err = (&controller.TransportMapReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Clients:  clients,
+		Recorder: mgr.GetEventRecorderFor("transportmap-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "TransportMap")
+		os.Exit(1)
+	}
+	if err = (&controller.DomainPolicyReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Clients:  clients,
+		Recorder: mgr.GetEventRecorderFor("domainpolicy-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "DomainPolicy")
+		os.Exit(1)
+	}
+	if err = (&controller.SyncJobReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Clients:  clients,
+		Recorder: mgr.GetEventRecorderFor("syncjob-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "SyncJob")
+		os.Exit(1)
+	}
+	if err = (&controller.AppPasswordReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Clients:  clients,
+		Recorder: mgr.GetEventRecorderFor("apppassword-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "AppPassword")
+		os.Exit(1)
+	}
+	if err = (&controller.MailcowReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Clients:  clients,
+		Recorder: mgr.GetEventRecorderFor("mailcow-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "Mailcow")
+		os.Exit(1)
+	}
+	if err = (&controller.ClusterMailcowReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Clients:  clients,
+		Recorder: mgr.GetEventRecorderFor("clustermailcow-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "ClusterMailcow")
+		os.Exit(1)
+	}
+	// nolint:goconst
+	if os.Getenv("ENABLE_WEBHOOKS") != "false" {
+		webhooks := []struct {
+			kind  string
+			setup func(ctrl.Manager) error
+		}{
+			{"Mailcow", webhookmailcowv1.SetupMailcowWebhookWithManager},
+			{"ClusterMailcow", webhookmailcowv1.SetupClusterMailcowWebhookWithManager},
+			{"Domain", webhookmailcowv1.SetupDomainWebhookWithManager},
+			{"Mailbox", webhookmailcowv1.SetupMailboxWebhookWithManager},
+			{"DomainAdmin", webhookmailcowv1.SetupDomainAdminWebhookWithManager},
+			{"Alias", webhookmailcowv1.SetupAliasWebhookWithManager},
+			{"BCCMap", webhookmailcowv1.SetupBCCMapWebhookWithManager},
+			{"RecipientMap", webhookmailcowv1.SetupRecipientMapWebhookWithManager},
+			{"TLSPolicyMap", webhookmailcowv1.SetupTLSPolicyMapWebhookWithManager},
+			{"RelayHost", webhookmailcowv1.SetupRelayHostWebhookWithManager},
+			{"TransportMap", webhookmailcowv1.SetupTransportMapWebhookWithManager},
+			{"DomainPolicy", webhookmailcowv1.SetupDomainPolicyWebhookWithManager},
+		}
+		for _, webhook := range webhooks {
+			if err = webhook.setup(mgr); err != nil {
+				setupLog.Error(err, "unable to create webhook", "webhook", webhook.kind)
+				os.Exit(1)
+			}
+		}
+	}
 	// +kubebuilder:scaffold:builder
 
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
@@ -2,8 +2,15 @@ package common
 
 const Finalizer = "mailcow.onestein.nl/finalizer"
 
+// AnnotationAdopt marks a resource that adopts an object that already existed in mailcow.
+// Adopted objects are updated in place and never recreated.
+const AnnotationAdopt = "mailcow.onestein.nl/adopt"
+
 const (
 	ConditionReady       = "Ready"
 	ConditionDegraded    = "Degraded"
 	ConditionProgressing = "Progressing"
+	ConditionDrifted     = "Drifted"
+	// ConditionQuotaNearlyFull is set on a mailbox using more than the threshold of its quota.
+	ConditionQuotaNearlyFull = "QuotaNearlyFull"
 )
@@ -20,3 +20,29 @@ func BooleanToInt(b *bool) *int {
 		return &i
 	}
 }
+
+func IntToInt64(n *int) *int64 {
+	if n == nil {
+		return nil
+	}
+	i := int64(*n)
+	return &i
+}
+
+func BoolToFloat32(b *bool) *float32 {
+	if b == nil {
+		return nil
+	}
+	var f float32
+	if *b {
+		f = 1
+	}
+	return &f
+}
+
+func IntPtrEqual(a, b *int) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -0,0 +1,66 @@
+package helpers
+
+import (
+	"slices"
+	"strings"
+)
+
+// bytesPerMegabyte converts the quotas mailcow returns in bytes to the megabytes used in the specs.
+const bytesPerMegabyte = 1024 * 1024
+
+// BoolDrifted returns true if the desired value differs from the 0/1 value mailcow returns.
+// Unknown values are never reported as drifted.
+func BoolDrifted(desired *bool, live *int) bool {
+	if desired == nil || live == nil {
+		return false
+	}
+	return *desired != (*live != 0)
+}
+
+// BoolStringDrifted returns true if the desired value differs from the "0"/"1" value mailcow returns.
+func BoolStringDrifted(desired *bool, live *string) bool {
+	if desired == nil || live == nil {
+		return false
+	}
+	return *desired != (*live != "0")
+}
+
+// IntDrifted returns true if the desired value differs from the value mailcow returns.
+func IntDrifted(desired *int64, live *int) bool {
+	if desired == nil || live == nil {
+		return false
+	}
+	return *desired != int64(*live)
+}
+
+// QuotaDrifted returns true if the desired quota in megabytes differs from the quota in bytes mailcow returns.
+func QuotaDrifted(desired *int64, live *int) bool {
+	if desired == nil || live == nil {
+		return false
+	}
+	return *desired != int64(*live)/bytesPerMegabyte
+}
+
+// StringDrifted returns true if the desired value differs from the value mailcow returns.
+func StringDrifted(desired string, live *string) bool {
+	if live == nil {
+		return false
+	}
+	return desired != *live
+}
+
+// ListDrifted returns true if the desired and live lists don't hold the same values, ignoring order and case.
+func ListDrifted(desired []string, live []string) bool {
+	normalize := func(values []string) []string {
+		normalized := []string{}
+		for _, value := range values {
+			value = strings.ToLower(strings.TrimSpace(value))
+			if value != "" && !slices.Contains(normalized, value) {
+				normalized = append(normalized, value)
+			}
+		}
+		slices.Sort(normalized)
+		return normalized
+	}
+	return !slices.Equal(normalize(desired), normalize(live))
+}
@@ -0,0 +1,13 @@
+package helpers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+// Hash returns a sha256 hex digest of the given values, used to detect changes without storing the values.
+func Hash(values ...string) string {
+	sum := sha256.Sum256([]byte(strings.Join(values, "\x00")))
+	return hex.EncodeToString(sum[:])
+}
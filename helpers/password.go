@@ -0,0 +1,75 @@
+package helpers
+
+import (
+	"crypto/rand"
+	"math/big"
+)
+
+const (
+	passwordCharset = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+
+	lowercaseCharset = "abcdefghijklmnopqrstuvwxyz"
+	uppercaseCharset = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	digitCharset     = "0123456789"
+	// specialCharset leaves out quotes, backslashes and spaces, which break when the password is used in a shell or config file
+	specialCharset = "!#$%&()*+,-./:;<=>?@[]^_{|}~"
+)
+
+// GeneratePassword returns a random alphanumeric password of the given length.
+func GeneratePassword(length int) (string, error) {
+	password := make([]byte, length)
+	for i := range password {
+		c, err := randomChar(passwordCharset)
+		if err != nil {
+			return "", err
+		}
+		password[i] = c
+	}
+	return string(password), nil
+}
+
+// GenerateStrongPassword returns a random password of the given length with at least one lowercase letter, one
+// uppercase letter and one digit, and with special also one special character, to meet the mailcow password policy.
+func GenerateStrongPassword(length int, special bool) (string, error) {
+	classes := []string{lowercaseCharset, uppercaseCharset, digitCharset}
+	if special {
+		classes = append(classes, specialCharset)
+	}
+	charset := ""
+	for _, class := range classes {
+		charset += class
+	}
+
+	password := make([]byte, max(length, len(classes)))
+	for i := range password {
+		// The first characters are taken from each class, they are shuffled into place below
+		class := charset
+		if i < len(classes) {
+			class = classes[i]
+		}
+		c, err := randomChar(class)
+		if err != nil {
+			return "", err
+		}
+		password[i] = c
+	}
+
+	// Fisher-Yates shuffle
+	for i := len(password) - 1; i > 0; i-- {
+		j, err := rand.Int(rand.Reader, big.NewInt(int64(i+1)))
+		if err != nil {
+			return "", err
+		}
+		password[i], password[j.Int64()] = password[j.Int64()], password[i]
+	}
+	return string(password), nil
+}
+
+// randomChar returns a random character of the charset.
+func randomChar(charset string) (byte, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(int64(len(charset))))
+	if err != nil {
+		return 0, err
+	}
+	return charset[n.Int64()], nil
+}
@@ -9,6 +9,10 @@ import (
 // It sets the specified condition to the given status and reason/message,
 // and sets all other standard conditions (Ready, Progressing, Degraded) to False while preserving
 // their existing reason/message.
+//
+// The conditions are only touched when the stored condition differs, so a status written in between by the caller
+// never persists half of a transition. Progressing is not raised again for a generation that already has the same
+// message, otherwise every resync of a Ready resource would flip it back to Progressing.
 func SetConditionStatus(
 	conditions *[]metav1.Condition,
 	conditionType string,
@@ -16,29 +20,20 @@ func SetConditionStatus(
 	message string,
 	generation int64,
 ) bool {
-	changed := false
+	if stored := meta.FindStatusCondition(*conditions, conditionType); stored != nil &&
+		stored.ObservedGeneration == generation && stored.Reason == reason && stored.Message == message &&
+		(stored.Status == metav1.ConditionTrue || conditionType == "Progressing") {
+		return false
+	}
 
 	// Set the active condition
-	// If it already exists, only update if generation has changed
-	if statusCondition := meta.FindStatusCondition(*conditions, conditionType); statusCondition != nil {
-		if statusCondition.ObservedGeneration != generation || statusCondition.Message != message {
-			changed = meta.SetStatusCondition(conditions, metav1.Condition{
-				Type:               conditionType,
-				Status:             metav1.ConditionTrue,
-				Reason:             reason,
-				Message:            message,
-				ObservedGeneration: generation,
-			})
-		}
-	} else {
-		changed = meta.SetStatusCondition(conditions, metav1.Condition{
-			Type:               conditionType,
-			Status:             metav1.ConditionTrue,
-			Reason:             reason,
-			Message:            message,
-			ObservedGeneration: generation,
-		})
-	}
+	meta.SetStatusCondition(conditions, metav1.Condition{
+		Type:               conditionType,
+		Status:             metav1.ConditionTrue,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: generation,
+	})
 
 	// Set other conditions to False, preserving their reason/message
 	otherTypes := []string{"Ready", "Progressing", "Degraded"}
@@ -56,5 +51,12 @@ func SetConditionStatus(
 			})
 		}
 	}
-	return changed
+	return true
+}
+
+// IsReconciled returns true if the Ready condition was set for the given generation,
+// i.e. the current spec has been applied successfully before.
+func IsReconciled(conditions []metav1.Condition, generation int64) bool {
+	condition := meta.FindStatusCondition(conditions, "Ready")
+	return condition != nil && condition.ObservedGeneration == generation
 }
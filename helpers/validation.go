@@ -0,0 +1,54 @@
+package helpers
+
+import (
+	"net"
+	"net/mail"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// IsDomainName returns true if the value is a fully qualified domain name, e.g. example.com.
+func IsDomainName(value string) bool {
+	return len(validation.IsFullyQualifiedDomainName(nil, strings.ToLower(value))) == 0
+}
+
+// IsEmail returns true if the value is a plain email address, e.g. info@example.com.
+// Display names and angle brackets are not allowed.
+func IsEmail(value string) bool {
+	address, err := mail.ParseAddress(value)
+	if err != nil || address.Name != "" || address.Address != value {
+		return false
+	}
+	return IsDomainName(EmailDomain(value))
+}
+
+// EmailDomain returns the part after the last @ of an email address, or an empty string if there is none.
+func EmailDomain(value string) string {
+	i := strings.LastIndex(value, "@")
+	if i < 0 {
+		return ""
+	}
+	return value[i+1:]
+}
+
+// IsHostPort returns true if the value is a host with an optional port, e.g. smtp.example.com:587. The host may be
+// written in brackets, e.g. [smtp.example.com]:587, which makes postfix skip the MX lookup.
+func IsHostPort(value string) bool {
+	host := value
+	if h, port, err := net.SplitHostPort(value); err == nil {
+		if n, err := strconv.Atoi(port); err != nil || n < 1 || n > 65535 {
+			return false
+		}
+		host = h
+	}
+	host = strings.TrimSuffix(strings.TrimPrefix(host, "["), "]")
+	return IsDomainName(host) || net.ParseIP(host) != nil
+}
+
+// IsPolicyAddress returns true if the value can be put on the allow or deny list of a domain: an email address, a
+// domain, or a wildcard matching a whole domain, e.g. *@example.com.
+func IsPolicyAddress(value string) bool {
+	return IsEmail(value) || IsDomainName(strings.TrimPrefix(value, "*@"))
+}
@@ -18,10 +18,14 @@ package controller
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -36,12 +40,15 @@ import (
 // AliasReconciler reconciles a Alias object
 type AliasReconciler struct {
 	client.Client
-	Scheme *runtime.Scheme
+	Scheme   *runtime.Scheme
+	Clients  *MailcowClients
+	Recorder record.EventRecorder
 }
 
 // +kubebuilder:rbac:groups=mailcow.onestein.nl,resources=aliases,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=mailcow.onestein.nl,resources=aliases/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=mailcow.onestein.nl,resources=aliases/finalizers,verbs=update
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -91,11 +98,16 @@ func (r *AliasReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl
 	if err := r.ReconcileResource(ctx, &alias); err != nil {
 		log.Error(err, "unable to reconcile mailcow alias")
 		// Set degraded status
-		if _, errStatus := r.setDegraded(ctx, &alias, err.Error()); errStatus != nil {
+		changed, errStatus := r.setDegraded(ctx, &alias, errorReason(err, "ReconcileFailed"), err.Error())
+		if errStatus != nil {
 			log.Error(errStatus, "unable to set degraded status")
 			return ctrl.Result{}, errStatus
 		}
-		return ctrl.Result{}, err
+		// Only record the error when it changed, so requeues don't repeat the same event
+		if changed {
+			recordError(r.Recorder, &alias, err)
+		}
+		return handleReconcileError(ctx, r.Client, alias.Namespace, alias.GetMailcowRef(), err)
 	}
 
 	// Remove finalizer if deletion timestamp is set
@@ -115,22 +127,51 @@ func (r *AliasReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl
 		return ctrl.Result{}, err
 	}
 
-	return ctrl.Result{}, nil
+	// Requeue to detect drift in mailcow
+	return ctrl.Result{RequeueAfter: resyncInterval(ctx, r, alias.Namespace, alias.GetMailcowRef(), alias.Spec.ResyncInterval)}, nil
 }
 
 func (r *AliasReconciler) ReconcileResource(ctx context.Context, alias *mailcowv1.Alias) error {
 	log := log.FromContext(ctx).WithValues("namespace", types.NamespacedName{Namespace: alias.Namespace, Name: alias.Name})
 	var err error
 
+	// A alias retained by its own deletionPolicy needs no mailcow, so it can be deleted after its mailcow
+	if !alias.ObjectMeta.DeletionTimestamp.IsZero() && alias.Spec.DeletionPolicy == mailcowv1.DeletionPolicyRetain {
+		log.Info("retaining alias in mailcow")
+		return nil
+	}
+
 	// Get related mailcow resource
-	var res mailcowv1.Mailcow
-	if err := r.Get(ctx, types.NamespacedName{Name: alias.Spec.Mailcow, Namespace: alias.Namespace}, &res); err != nil {
-		log.Error(err, "unable to find related mailcow resource", "mailcow", alias.Spec.Mailcow)
+	res, err := mailcowv1.GetMailcow(ctx, r, alias.Namespace, alias.GetMailcowRef())
+	if err != nil {
+		// On deletion, e.g. during a namespace teardown, a mailcow that is gone leaves nothing to clean up
+		if !alias.ObjectMeta.DeletionTimestamp.IsZero() && errors.IsNotFound(err) {
+			log.Info("leaving alias untouched, its mailcow no longer exists")
+			return nil
+		}
+		log.Error(err, "unable to find related mailcow resource", "mailcow", alias.GetMailcowRef().String())
 		return err
 	}
 
+	// Objects in a domain of another namespace are never touched in mailcow, also not on deletion
+	if err := checkDomainOwnership(ctx, r, alias.Namespace, alias.GetMailcowRef(), helpers.EmailDomain(alias.Spec.Address)); err != nil {
+		if !alias.ObjectMeta.DeletionTimestamp.IsZero() && isDomainNotAllowed(err) {
+			log.Info("leaving alias in a domain not owned by the namespace untouched in mailcow")
+			return nil
+		}
+		log.Error(err, "unable to use the domain of the alias")
+		return err
+	}
+
+	// Retain leaves the alias in mailcow untouched on deletion
+	deletionPolicy := res.GetDeletionPolicy(alias.Spec.DeletionPolicy)
+	if !alias.ObjectMeta.DeletionTimestamp.IsZero() && deletionPolicy == mailcowv1.DeletionPolicyRetain {
+		log.Info("retaining alias in mailcow")
+		return nil
+	}
+
 	// Reconcile mailcow alias
-	client, err := res.GetClient(ctx, r)
+	client, err := r.Clients.Get(ctx, r, res)
 	if err != nil {
 		log.Error(err, "unable to create mailcow client")
 		return err
@@ -149,17 +190,34 @@ func (r *AliasReconciler) ReconcileResource(ctx context.Context, alias *mailcowv
 
 	if !alias.ObjectMeta.DeletionTimestamp.IsZero() {
 		// Handle deletion
-		if aliasExists {
+		if aliasExists && deletionPolicy == mailcowv1.DeletionPolicyDisable {
+			active := false
+			_, err = client.UpdateAliasWithResponse(ctx, mailcow.UpdateAliasJSONRequestBody{
+				Attr:  &mailcow.EditAliasAttr{Active: &active},
+				Items: &[]string{alias.Spec.Address},
+			})
+			if err != nil {
+				log.Error(err, "unable to disable alias")
+				return err
+			}
+			r.Recorder.Eventf(alias, corev1.EventTypeNormal, "Disabled", "Disabled alias %s in mailcow", alias.Spec.Address)
+		} else if aliasExists {
 			_, err = client.DeleteAliasWithResponse(ctx, mailcow.DeleteAliasJSONRequestBody{alias.Spec.Address})
 			if err != nil {
 				log.Error(err, "unable to delete alias")
 				return err
 			}
+			r.Recorder.Eventf(alias, corev1.EventTypeNormal, "Deleted", "Deleted alias %s from mailcow", alias.Spec.Address)
 		}
 		return nil
 	}
 
 	if !aliasExists {
+		// Adopted aliases are never recreated
+		if alias.Annotations[constants.AnnotationAdopt] == "true" {
+			return fmt.Errorf("adopted alias %s does not exist in mailcow", alias.Spec.Address)
+		}
+
 		// Alias does not exist, create it
 		_, err = client.CreateAliasWithResponse(ctx, mailcow.CreateAliasJSONRequestBody{
 			Address: &alias.Spec.Address,
@@ -171,8 +229,27 @@ func (r *AliasReconciler) ReconcileResource(ctx context.Context, alias *mailcowv
 			log.Error(err, "unable to create alias")
 			return err
 		}
+		r.Recorder.Eventf(alias, corev1.EventTypeNormal, "Created", "Created alias %s in mailcow", alias.Spec.Address)
 	} else {
-		// Alias exists, update it
+		// Alias exists, compare it against the spec
+		var drifted []string
+		if helpers.BoolDrifted(&alias.Spec.Active, response.JSON200.Active) {
+			drifted = append(drifted, "active")
+		}
+		if response.JSON200.Goto != nil && helpers.ListDrifted(strings.Split(alias.Spec.GoTo, ","), strings.Split(*response.JSON200.Goto, ",")) {
+			drifted = append(drifted, "goTo")
+		}
+
+		if err := recordDrift(ctx, r.Client, r.Recorder, alias, &alias.Status.Conditions, drifted); err != nil {
+			log.Error(err, "unable to record drift")
+			return err
+		}
+
+		if len(drifted) == 0 && helpers.IsReconciled(alias.Status.Conditions, alias.Generation) {
+			return nil
+		}
+
+		// Alias drifted or the spec changed, update it
 		_, err = client.UpdateAliasWithResponse(ctx, mailcow.UpdateAliasJSONRequestBody{
 			Attr: &mailcow.EditAliasAttr{
 				Goto:   &alias.Spec.GoTo,
@@ -185,6 +262,7 @@ func (r *AliasReconciler) ReconcileResource(ctx context.Context, alias *mailcowv
 			log.Error(err, "unable to update alias")
 			return err
 		}
+		r.Recorder.Eventf(alias, corev1.EventTypeNormal, "Updated", "Updated alias %s in mailcow", alias.Spec.Address)
 	}
 
 	return nil
@@ -216,8 +294,8 @@ func (r *AliasReconciler) setReady(ctx context.Context, alias *mailcowv1.Alias,
 	return changed, r.Status().Update(ctx, alias)
 }
 
-func (r *AliasReconciler) setDegraded(ctx context.Context, alias *mailcowv1.Alias, message string) (bool, error) {
-	changed := helpers.SetConditionStatus(&alias.Status.Conditions, constants.ConditionDegraded, "ReconcileFailed", message, alias.Generation)
+func (r *AliasReconciler) setDegraded(ctx context.Context, alias *mailcowv1.Alias, reason, message string) (bool, error) {
+	changed := helpers.SetConditionStatus(&alias.Status.Conditions, constants.ConditionDegraded, reason, message, alias.Generation)
 	if !changed {
 		return changed, nil
 	}
@@ -0,0 +1,444 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	mailcowv1 "github.com/tarteo/mailcow-operator/api/v1"
+	constants "github.com/tarteo/mailcow-operator/common"
+	helpers "github.com/tarteo/mailcow-operator/helpers"
+	"github.com/tarteo/mailcow-operator/mailcow"
+)
+
+const (
+	appPasswordLength = 32
+	smtpPort          = "465"
+	imapPort          = "993"
+)
+
+// AppPasswordReconciler reconciles a AppPassword object
+type AppPasswordReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Clients  *MailcowClients
+	Recorder record.EventRecorder
+}
+
+// +kubebuilder:rbac:groups=mailcow.onestein.nl,resources=apppasswords,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=mailcow.onestein.nl,resources=apppasswords/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=mailcow.onestein.nl,resources=apppasswords/finalizers,verbs=update
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+//
+// For more details, check Reconcile and its Result here:
+// - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.19.1/pkg/reconcile
+func (r *AppPasswordReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := log.FromContext(ctx).WithValues("namespace", req.NamespacedName)
+	log.Info("reconciling apppassword")
+
+	var apppassword mailcowv1.AppPassword
+	if err := r.Get(ctx, req.NamespacedName, &apppassword); err != nil {
+		if errors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		log.Error(err, "unable to find apppassword")
+		return ctrl.Result{}, err
+	}
+
+	// Apply finalizer
+	if apppassword.ObjectMeta.DeletionTimestamp.IsZero() {
+		if !controllerutil.ContainsFinalizer(&apppassword, constants.Finalizer) {
+			controllerutil.AddFinalizer(&apppassword, constants.Finalizer)
+			if err := r.Update(ctx, &apppassword); err != nil {
+				log.Error(err, "unable to update apppassword with finalizer")
+				return ctrl.Result{}, err
+			}
+
+			// Return and requeue to get fresh object
+			return ctrl.Result{Requeue: true}, nil
+		}
+		// Set progressing status
+		if changed, err := r.setProgressing(ctx, &apppassword, "Reconciling apppassword"); err != nil {
+			log.Error(err, "unable to set progressing status")
+			return ctrl.Result{}, err
+		} else if changed {
+			// Requeue to get fresh object with updated status
+			return ctrl.Result{Requeue: true}, nil
+		}
+	}
+
+	if err := r.ReconcileResource(ctx, &apppassword); err != nil {
+		log.Error(err, "unable to reconcile mailcow apppassword")
+		// Set degraded status
+		changed, errStatus := r.setDegraded(ctx, &apppassword, errorReason(err, "ReconcileFailed"), err.Error())
+		if errStatus != nil {
+			log.Error(errStatus, "unable to set degraded status")
+			return ctrl.Result{}, errStatus
+		}
+		// Only record the error when it changed, so requeues don't repeat the same event
+		if changed {
+			recordError(r.Recorder, &apppassword, err)
+		}
+		return handleReconcileError(ctx, r.Client, apppassword.Namespace, mailboxMailcow(ctx, r, apppassword.Namespace, apppassword.Spec.Mailbox), err)
+	}
+
+	// Remove finalizer if deletion timestamp is set
+	if !apppassword.ObjectMeta.DeletionTimestamp.IsZero() && controllerutil.ContainsFinalizer(&apppassword, constants.Finalizer) {
+		controllerutil.RemoveFinalizer(&apppassword, constants.Finalizer)
+		if err := r.Update(ctx, &apppassword); err != nil {
+			log.Error(err, "unable to update apppassword with finalizer")
+			return ctrl.Result{}, err
+		}
+
+		return ctrl.Result{}, nil
+	}
+
+	// Set ready status
+	if _, err := r.setReady(ctx, &apppassword, "AppPassword successfully reconciled"); err != nil {
+		log.Error(err, "unable to set ready status")
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
+
+func (r *AppPasswordReconciler) ReconcileResource(ctx context.Context, apppassword *mailcowv1.AppPassword) error {
+	log := log.FromContext(ctx).WithValues("namespace", types.NamespacedName{Namespace: apppassword.Namespace, Name: apppassword.Name})
+	var err error
+
+	// Get related mailbox resource
+	var mailbox mailcowv1.Mailbox
+	if err := r.Get(ctx, types.NamespacedName{Name: apppassword.Spec.Mailbox, Namespace: apppassword.Namespace}, &mailbox); err != nil {
+		// On deletion, e.g. during a namespace teardown, a mailbox that is gone leaves nothing to clean up
+		if !apppassword.ObjectMeta.DeletionTimestamp.IsZero() && errors.IsNotFound(err) {
+			log.Info("leaving apppassword untouched, its mailbox no longer exists")
+			return nil
+		}
+		log.Error(err, "unable to find related mailbox resource", "mailbox", apppassword.Spec.Mailbox)
+		return err
+	}
+
+	// Get related mailcow resource
+	res, err := mailcowv1.GetMailcow(ctx, r, apppassword.Namespace, mailbox.GetMailcowRef())
+	if err != nil {
+		if !apppassword.ObjectMeta.DeletionTimestamp.IsZero() && errors.IsNotFound(err) {
+			log.Info("leaving apppassword untouched, its mailcow no longer exists")
+			return nil
+		}
+		log.Error(err, "unable to find related mailcow resource", "mailcow", mailbox.GetMailcowRef().String())
+		return err
+	}
+
+	// The mailbox may be in a domain of another namespace, e.g. with the webhooks disabled, its app passwords are never
+	// touched in mailcow, also not on deletion
+	if err := checkDomainOwnership(ctx, r, apppassword.Namespace, mailbox.GetMailcowRef(), mailbox.Spec.Domain); err != nil {
+		if !apppassword.ObjectMeta.DeletionTimestamp.IsZero() && isDomainNotAllowed(err) {
+			log.Info("leaving apppassword of a mailbox in a domain not owned by the namespace untouched in mailcow")
+			return nil
+		}
+		log.Error(err, "unable to use the domain of the mailbox")
+		return err
+	}
+
+	// Create mailcow client
+	client, err := r.Clients.Get(ctx, r, res)
+	if err != nil {
+		log.Error(err, "unable to create mailcow client")
+		return err
+	}
+
+	email := mailbox.Spec.LocalPart + "@" + mailbox.Spec.Domain
+	appName := apppassword.GetAppName()
+
+	id, err := r.findAppPassword(ctx, client, apppassword, email)
+	if err != nil {
+		log.Error(err, "unable to get app passwords")
+		return err
+	}
+
+	// An app password found by its name was not created by the resource, it is only managed when adopted
+	if id != nil && !helpers.IntPtrEqual(id, apppassword.Status.ID) && apppassword.Annotations[constants.AnnotationAdopt] != "true" {
+		if !apppassword.ObjectMeta.DeletionTimestamp.IsZero() {
+			log.Info("leaving apppassword not created by the resource untouched in mailcow")
+			return nil
+		}
+		return &notAdoptedError{Object: fmt.Sprintf("app password %s of %s", appName, email)}
+	}
+
+	if !apppassword.ObjectMeta.DeletionTimestamp.IsZero() {
+		// Handle deletion, the credentials secret is removed through its owner reference
+		if id != nil {
+			_, err = client.DeleteAppPasswordWithResponse(ctx, mailcow.DeleteAppPasswordJSONRequestBody{strconv.Itoa(*id)})
+			if err != nil {
+				log.Error(err, "unable to delete apppassword")
+				return err
+			}
+			r.Recorder.Eventf(apppassword, corev1.EventTypeNormal, "Deleted", "Deleted app password %s of %s from mailcow", appName, email)
+		}
+		return nil
+	}
+
+	// Get the password from the referenced secret or from the credentials secret, generate one otherwise
+	password, err := apppassword.GetPassword(ctx, r)
+	if err != nil {
+		log.Error(err, "unable to get password from secret")
+		return err
+	}
+
+	var existingSecret corev1.Secret
+	secretExists := true
+	if err := r.Get(ctx, types.NamespacedName{Name: apppassword.GetSecretName(), Namespace: apppassword.Namespace}, &existingSecret); err != nil {
+		if !errors.IsNotFound(err) {
+			log.Error(err, "unable to get credentials secret")
+			return err
+		}
+		secretExists = false
+	}
+	// A secret of someone else must neither set the app password nor receive the credentials
+	if secretExists && !metav1.IsControlledBy(&existingSecret, apppassword) {
+		return fmt.Errorf("secret `%s` already exists and is not owned by this app password", existingSecret.Name)
+	}
+
+	if password == "" {
+		password = string(existingSecret.Data["password"])
+	}
+	if password == "" {
+		log.Info("generating app password")
+		if password, err = helpers.GeneratePassword(appPasswordLength); err != nil {
+			log.Error(err, "unable to generate password")
+			return err
+		}
+	}
+
+	protocols := make([]string, 0, len(apppassword.Spec.Protocols))
+	for _, protocol := range apppassword.Spec.Protocols {
+		protocols = append(protocols, string(protocol)+"_access")
+	}
+	sort.Strings(protocols)
+
+	active := apppassword.Spec.Active == nil || *apppassword.Spec.Active
+	// Salt with the uid, so the hash in the status can't be compared across resources
+	hash := helpers.Hash(string(apppassword.UID), appName, password, strings.Join(protocols, ","), strconv.FormatBool(active))
+
+	if id == nil {
+		// AppPassword does not exist, create it
+		createProtocols := make([]mailcow.CreateAppPasswordJSONBodyProtocols, 0, len(protocols))
+		for _, protocol := range protocols {
+			createProtocols = append(createProtocols, mailcow.CreateAppPasswordJSONBodyProtocols(protocol))
+		}
+		_, err = client.CreateAppPasswordWithResponse(ctx, mailcow.CreateAppPasswordJSONRequestBody{
+			Username:   &email,
+			AppName:    &appName,
+			AppPasswd:  &password,
+			AppPasswd2: &password,
+			Protocols:  &createProtocols,
+			Active:     &active,
+		})
+
+		if err != nil {
+			log.Error(err, "unable to create apppassword")
+			return err
+		}
+
+		// Mailcow doesn't return the id of the created app password, look it up
+		id, err = r.findAppPassword(ctx, client, apppassword, email)
+		if err != nil {
+			log.Error(err, "unable to get created apppassword")
+			return err
+		}
+		if id == nil {
+			return fmt.Errorf("created app password %s of %s not found in mailcow", appName, email)
+		}
+		r.Recorder.Eventf(apppassword, corev1.EventTypeNormal, "Created", "Created app password %s of %s in mailcow", appName, email)
+	} else if hash != apppassword.Status.Hash {
+		// AppPassword exists and its settings changed, update it
+		_, err = client.UpdateAppPasswordWithResponse(ctx, mailcow.UpdateAppPasswordJSONRequestBody{
+			Attr: &mailcow.EditAppPasswordAttr{
+				AppName:    &appName,
+				AppPasswd:  &password,
+				AppPasswd2: &password,
+				Protocols:  &protocols,
+				Active:     &active,
+			},
+			Items: &[]string{strconv.Itoa(*id)},
+		})
+
+		if err != nil {
+			log.Error(err, "unable to update apppassword")
+			return err
+		}
+		r.Recorder.Eventf(apppassword, corev1.EventTypeNormal, "Updated", "Updated app password %s of %s in mailcow", appName, email)
+	}
+
+	// Write the credentials and connection details into the secret
+	hostname := res.GetHostname()
+	data := map[string][]byte{
+		"username": []byte(email),
+		"password": []byte(password),
+		"smtpHost": []byte(hostname),
+		"smtpPort": []byte(smtpPort),
+		"imapHost": []byte(hostname),
+		"imapPort": []byte(imapPort),
+	}
+
+	if !secretExists {
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      apppassword.GetSecretName(),
+				Namespace: apppassword.Namespace,
+				OwnerReferences: []metav1.OwnerReference{
+					*metav1.NewControllerRef(apppassword, mailcowv1.GroupVersion.WithKind("AppPassword")),
+				},
+			},
+			Type: corev1.SecretTypeOpaque,
+			Data: data,
+		}
+		if err := r.Create(ctx, secret); err != nil {
+			log.Error(err, "unable to create credentials secret")
+			return err
+		}
+		log.Info("created credentials secret")
+	} else if !equality.Semantic.DeepEqual(existingSecret.Data, data) {
+		existingSecret.Data = data
+		if err := r.Update(ctx, &existingSecret); err != nil {
+			log.Error(err, "unable to update credentials secret")
+			return err
+		}
+	}
+
+	// Update status
+	if id != nil && (apppassword.Status.ID == nil || *apppassword.Status.ID != *id || apppassword.Status.Hash != hash || apppassword.Status.SecretName != apppassword.GetSecretName()) {
+		apppassword.Status.ID = id
+		apppassword.Status.Hash = hash
+		apppassword.Status.SecretName = apppassword.GetSecretName()
+		if err := r.Status().Update(ctx, apppassword); err != nil {
+			log.Error(err, "unable to update apppassword status")
+			return err
+		}
+	}
+
+	return nil
+}
+
+// findAppPassword returns the mailcow id of the app password of this resource, or nil if it doesn't exist.
+// The id in the status is used when known, otherwise or when that app password is gone it is matched on its name.
+// Such a match is only managed when the resource just created it or adopts it.
+func (r *AppPasswordReconciler) findAppPassword(ctx context.Context, client *mailcow.ClientWithResponses, apppassword *mailcowv1.AppPassword, email string) (*int, error) {
+	appPasswords, err := client.ListAppPasswords(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+
+	if apppassword.Status.ID != nil {
+		for _, ap := range appPasswords {
+			if ap.Id != nil && *ap.Id == *apppassword.Status.ID {
+				return ap.Id, nil
+			}
+		}
+	}
+
+	for _, ap := range appPasswords {
+		if ap.Id != nil && ap.Name != nil && *ap.Name == apppassword.GetAppName() {
+			return ap.Id, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *AppPasswordReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &mailcowv1.AppPassword{}, passwordSecretIndex, func(obj client.Object) []string {
+		if selector := obj.(*mailcowv1.AppPassword).Spec.PasswordSecret; selector != nil {
+			return []string{selector.Name}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&mailcowv1.AppPassword{}).
+		Owns(&corev1.Secret{}).
+		Watches(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(r.findAppPasswordsForSecret)).
+		Named("apppassword").
+		Complete(r)
+}
+
+// findAppPasswordsForSecret returns a request for every app password that uses the secret as password secret.
+func (r *AppPasswordReconciler) findAppPasswordsForSecret(ctx context.Context, secret client.Object) []reconcile.Request {
+	var apppasswords mailcowv1.AppPasswordList
+	if err := r.List(ctx, &apppasswords, client.InNamespace(secret.GetNamespace()), client.MatchingFields{passwordSecretIndex: secret.GetName()}); err != nil {
+		log.FromContext(ctx).Error(err, "unable to list apppasswords for secret", "secret", secret.GetName())
+		return nil
+	}
+
+	requests := make([]reconcile.Request, len(apppasswords.Items))
+	for i, apppassword := range apppasswords.Items {
+		requests[i] = reconcile.Request{NamespacedName: types.NamespacedName{Name: apppassword.Name, Namespace: apppassword.Namespace}}
+	}
+	return requests
+}
+
+func (r *AppPasswordReconciler) setProgressing(ctx context.Context, apppassword *mailcowv1.AppPassword, message string) (bool, error) {
+	changed := helpers.SetConditionStatus(&apppassword.Status.Conditions, constants.ConditionProgressing, "Reconciling", message, apppassword.Generation)
+	if !changed {
+		return changed, nil
+	}
+	apppassword.Status.Phase = constants.ConditionProgressing
+	return changed, r.Status().Update(ctx, apppassword)
+}
+
+func (r *AppPasswordReconciler) setReady(ctx context.Context, apppassword *mailcowv1.AppPassword, message string) (bool, error) {
+	changed := helpers.SetConditionStatus(&apppassword.Status.Conditions, constants.ConditionReady, "Reconciled", message, apppassword.Generation)
+	if !changed {
+		return changed, nil
+	}
+	apppassword.Status.Phase = constants.ConditionReady
+	return changed, r.Status().Update(ctx, apppassword)
+}
+
+func (r *AppPasswordReconciler) setDegraded(ctx context.Context, apppassword *mailcowv1.AppPassword, reason, message string) (bool, error) {
+	changed := helpers.SetConditionStatus(&apppassword.Status.Conditions, constants.ConditionDegraded, reason, message, apppassword.Generation)
+	if !changed {
+		return changed, nil
+	}
+	apppassword.Status.Phase = constants.ConditionDegraded
+	return changed, r.Status().Update(ctx, apppassword)
+}
@@ -0,0 +1,363 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	mailcowv1 "github.com/tarteo/mailcow-operator/api/v1"
+	constants "github.com/tarteo/mailcow-operator/common"
+	helpers "github.com/tarteo/mailcow-operator/helpers"
+	"github.com/tarteo/mailcow-operator/mailcow"
+)
+
+// BCCMapReconciler reconciles a BCCMap object
+type BCCMapReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Clients  *MailcowClients
+	Recorder record.EventRecorder
+}
+
+// +kubebuilder:rbac:groups=mailcow.onestein.nl,resources=bccmaps,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=mailcow.onestein.nl,resources=bccmaps/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=mailcow.onestein.nl,resources=bccmaps/finalizers,verbs=update
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+// Reconcile creates, updates and deletes the BCC map in mailcow.
+//
+// For more details, check Reconcile and its Result here:
+// - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.19.1/pkg/reconcile
+func (r *BCCMapReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := log.FromContext(ctx).WithValues("namespace", req.NamespacedName)
+	log.Info("reconciling bccmap")
+
+	var bccmap mailcowv1.BCCMap
+	if err := r.Get(ctx, req.NamespacedName, &bccmap); err != nil {
+		if errors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		log.Error(err, "unable to find bccmap")
+		return ctrl.Result{}, err
+	}
+
+	// Apply finalizer
+	if bccmap.ObjectMeta.DeletionTimestamp.IsZero() {
+		if !controllerutil.ContainsFinalizer(&bccmap, constants.Finalizer) {
+			controllerutil.AddFinalizer(&bccmap, constants.Finalizer)
+			if err := r.Update(ctx, &bccmap); err != nil {
+				log.Error(err, "unable to update bccmap with finalizer")
+				return ctrl.Result{}, err
+			}
+
+			// Return and requeue to get fresh object
+			return ctrl.Result{Requeue: true}, nil
+		}
+		// Set progressing status
+		if changed, err := r.setProgressing(ctx, &bccmap, "Reconciling BCC map"); err != nil {
+			log.Error(err, "unable to set progressing status")
+			return ctrl.Result{}, err
+		} else if changed {
+			// Requeue to get fresh object with updated status
+			return ctrl.Result{Requeue: true}, nil
+		}
+	}
+
+	// Reconcile the resource
+	if err := r.ReconcileResource(ctx, &bccmap); err != nil {
+		log.Error(err, "unable to reconcile mailcow bccmap")
+		// Set degraded status
+		changed, errStatus := r.setDegraded(ctx, &bccmap, errorReason(err, "ReconcileFailed"), err.Error())
+		if errStatus != nil {
+			log.Error(errStatus, "unable to set degraded status")
+			return ctrl.Result{}, errStatus
+		}
+		// Only record the error when it changed, so requeues don't repeat the same event
+		if changed {
+			recordError(r.Recorder, &bccmap, err)
+		}
+		return handleReconcileError(ctx, r.Client, bccmap.Namespace, bccmap.GetMailcowRef(), err)
+	}
+
+	// Remove finalizer if deletion timestamp is set
+	if !bccmap.ObjectMeta.DeletionTimestamp.IsZero() && controllerutil.ContainsFinalizer(&bccmap, constants.Finalizer) {
+		controllerutil.RemoveFinalizer(&bccmap, constants.Finalizer)
+		if err := r.Update(ctx, &bccmap); err != nil {
+			log.Error(err, "unable to update bccmap with finalizer")
+			return ctrl.Result{}, err
+		}
+
+		return ctrl.Result{}, nil
+	}
+
+	// Set ready status
+	if _, err := r.setReady(ctx, &bccmap, "BCC map successfully reconciled"); err != nil {
+		log.Error(err, "unable to set ready status")
+		return ctrl.Result{}, err
+	}
+
+	// Requeue to detect drift in mailcow
+	return ctrl.Result{RequeueAfter: resyncInterval(ctx, r, bccmap.Namespace, bccmap.GetMailcowRef(), bccmap.Spec.ResyncInterval)}, nil
+}
+
+func (r *BCCMapReconciler) ReconcileResource(ctx context.Context, bccmap *mailcowv1.BCCMap) error {
+	log := log.FromContext(ctx).WithValues("namespace", types.NamespacedName{Namespace: bccmap.Namespace, Name: bccmap.Name})
+	var err error
+
+	// A bccmap retained by its own deletionPolicy needs no mailcow, so it can be deleted after its mailcow
+	if !bccmap.ObjectMeta.DeletionTimestamp.IsZero() && bccmap.Spec.DeletionPolicy == mailcowv1.DeletionPolicyRetain {
+		log.Info("retaining bccmap in mailcow")
+		return nil
+	}
+
+	// Get related mailcow resource
+	res, err := mailcowv1.GetMailcow(ctx, r, bccmap.Namespace, bccmap.GetMailcowRef())
+	if err != nil {
+		// On deletion, e.g. during a namespace teardown, a mailcow that is gone leaves nothing to clean up
+		if !bccmap.ObjectMeta.DeletionTimestamp.IsZero() && errors.IsNotFound(err) {
+			log.Info("leaving bccmap untouched, its mailcow no longer exists")
+			return nil
+		}
+		log.Error(err, "unable to find related mailcow resource", "mailcow", bccmap.GetMailcowRef().String())
+		return err
+	}
+
+	deleting := !bccmap.ObjectMeta.DeletionTimestamp.IsZero()
+
+	// Resolve the local destination, on deletion the referenced resource may already be gone
+	localDest, owner, err := bccmap.GetLocalDest(ctx, r)
+	if err != nil && !(deleting && errors.IsNotFound(err)) {
+		log.Error(err, "unable to resolve local destination")
+		return err
+	}
+	if localDest == "" {
+		localDest = bccmap.Status.LocalDest
+	}
+
+	// Objects in a domain of another namespace are never touched in mailcow, also not on deletion
+	if err := checkDomainOwnership(ctx, r, bccmap.Namespace, bccmap.GetMailcowRef(), localDestDomain(localDest)); err != nil {
+		if deleting && isDomainNotAllowed(err) {
+			log.Info("leaving bccmap in a domain not owned by the namespace untouched in mailcow")
+			return nil
+		}
+		log.Error(err, "unable to use the domain of the bccmap")
+		return err
+	}
+
+	// Retain leaves the bccmap in mailcow untouched on deletion
+	deletionPolicy := res.GetDeletionPolicy(bccmap.Spec.DeletionPolicy)
+	if deleting && deletionPolicy == mailcowv1.DeletionPolicyRetain {
+		log.Info("retaining bccmap in mailcow")
+		return nil
+	}
+
+	if !deleting && owner != nil {
+		// The map follows the lifecycle of the referenced resource, it is garbage collected with it
+		if err := ensureOwnerReference(ctx, r.Client, r.Scheme, owner, bccmap); err != nil {
+			log.Error(err, "unable to set owner reference")
+			return err
+		}
+		if phase := localDestPhase(owner); phase != constants.ConditionReady {
+			return fmt.Errorf("%s `%s` is not ready", bccmap.Spec.LocalDestRef.Kind, owner.GetName())
+		}
+	}
+
+	// Reconcile mailcow bccmap
+	client, err := r.Clients.Get(ctx, r, res)
+	if err != nil {
+		log.Error(err, "unable to create mailcow client")
+		return err
+	}
+
+	live, err := r.findBCCMap(ctx, client, bccmap, localDest)
+	if err != nil {
+		log.Error(err, "unable to get bccmaps")
+		return err
+	}
+
+	if deleting {
+		// Handle deletion
+		if live != nil && deletionPolicy == mailcowv1.DeletionPolicyDisable {
+			active := false
+			_, err = client.UpdateBCCMapWithResponse(ctx, mailcow.UpdateBCCMapJSONRequestBody{
+				Attr:  &mailcow.EditBCCMapAttr{Active: &active},
+				Items: &[]string{strconv.Itoa(*live.Id)},
+			})
+			if err != nil {
+				log.Error(err, "unable to disable bccmap")
+				return err
+			}
+			r.Recorder.Eventf(bccmap, corev1.EventTypeNormal, "Disabled", "Disabled BCC map of %s in mailcow", localDest)
+		} else if live != nil {
+			_, err = client.DeleteBCCMapWithResponse(ctx, mailcow.DeleteBCCMapJSONRequestBody{strconv.Itoa(*live.Id)})
+			if err != nil {
+				log.Error(err, "unable to delete bccmap")
+				return err
+			}
+			r.Recorder.Eventf(bccmap, corev1.EventTypeNormal, "Deleted", "Deleted BCC map of %s from mailcow", localDest)
+		}
+		return nil
+	}
+
+	active := bccmap.Spec.Active == nil || *bccmap.Spec.Active
+	bccType := string(bccmap.Spec.Type)
+
+	if live == nil {
+		// Adopted bccmaps are never recreated
+		if bccmap.Annotations[constants.AnnotationAdopt] == "true" {
+			return fmt.Errorf("adopted BCC map of %s does not exist in mailcow", localDest)
+		}
+
+		// BCC map does not exist, create it
+		_, err = client.CreateBCCMapWithResponse(ctx, mailcow.CreateBCCMapJSONRequestBody{
+			Active:    helpers.BoolToFloat32(&active),
+			BccDest:   &bccmap.Spec.BCCDest,
+			LocalDest: &localDest,
+			Type:      &bccType,
+		})
+		if err != nil {
+			log.Error(err, "unable to create bccmap")
+			return err
+		}
+
+		// Mailcow doesn't return the id of the created BCC map, look it up
+		live, err = r.findBCCMap(ctx, client, bccmap, localDest)
+		if err != nil {
+			log.Error(err, "unable to get created bccmap")
+			return err
+		}
+		r.Recorder.Eventf(bccmap, corev1.EventTypeNormal, "Created", "Created BCC map of %s in mailcow", localDest)
+	} else {
+		// BCC map exists, compare it against the spec
+		var drifted []string
+		if liveActive := live.Active.String(); liveActive != "" && helpers.BoolStringDrifted(&active, &liveActive) {
+			drifted = append(drifted, "active")
+		}
+		if live.BccDest != nil && !strings.EqualFold(*live.BccDest, bccmap.Spec.BCCDest) {
+			drifted = append(drifted, "bccDest")
+		}
+
+		if err := recordDrift(ctx, r.Client, r.Recorder, bccmap, &bccmap.Status.Conditions, drifted); err != nil {
+			log.Error(err, "unable to record drift")
+			return err
+		}
+
+		if len(drifted) > 0 || !helpers.IsReconciled(bccmap.Status.Conditions, bccmap.Generation) {
+			// BCC map drifted or the spec changed, update it
+			_, err = client.UpdateBCCMapWithResponse(ctx, mailcow.UpdateBCCMapJSONRequestBody{
+				Attr: &mailcow.EditBCCMapAttr{
+					Active:  &active,
+					BccDest: &bccmap.Spec.BCCDest,
+				},
+				Items: &[]string{strconv.Itoa(*live.Id)},
+			})
+			if err != nil {
+				log.Error(err, "unable to update bccmap")
+				return err
+			}
+			r.Recorder.Eventf(bccmap, corev1.EventTypeNormal, "Updated", "Updated BCC map of %s in mailcow", localDest)
+		}
+	}
+
+	var id *int
+	if live != nil {
+		id = live.Id
+	}
+	if !helpers.IntPtrEqual(bccmap.Status.ID, id) || bccmap.Status.LocalDest != localDest {
+		bccmap.Status.ID = id
+		bccmap.Status.LocalDest = localDest
+		if err := r.Status().Update(ctx, bccmap); err != nil {
+			log.Error(err, "unable to update bccmap status")
+			return err
+		}
+	}
+
+	return nil
+}
+
+// findBCCMap returns the BCC map in mailcow by the id in the status, or by its local destination and type.
+func (r *BCCMapReconciler) findBCCMap(ctx context.Context, client *mailcow.ClientWithResponses, bccmap *mailcowv1.BCCMap, localDest string) (*mailcow.BCCMap, error) {
+	maps, err := client.ListBCCMaps(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, m := range maps {
+		if m.Id == nil {
+			continue
+		}
+		if bccmap.Status.ID != nil {
+			if *m.Id == *bccmap.Status.ID {
+				return &maps[i], nil
+			}
+			continue
+		}
+		if m.LocalDest != nil && m.Type != nil && *m.Type == string(bccmap.Spec.Type) && strings.EqualFold(strings.TrimPrefix(*m.LocalDest, "@"), strings.TrimPrefix(localDest, "@")) {
+			return &maps[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *BCCMapReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&mailcowv1.BCCMap{}).
+		Named("bccmap").
+		Complete(r)
+}
+
+func (r *BCCMapReconciler) setProgressing(ctx context.Context, bccmap *mailcowv1.BCCMap, message string) (bool, error) {
+	changed := helpers.SetConditionStatus(&bccmap.Status.Conditions, constants.ConditionProgressing, "Reconciling", message, bccmap.Generation)
+	if !changed {
+		return changed, nil
+	}
+	bccmap.Status.Phase = constants.ConditionProgressing
+	return changed, r.Status().Update(ctx, bccmap)
+}
+
+func (r *BCCMapReconciler) setReady(ctx context.Context, bccmap *mailcowv1.BCCMap, message string) (bool, error) {
+	changed := helpers.SetConditionStatus(&bccmap.Status.Conditions, constants.ConditionReady, "Reconciled", message, bccmap.Generation)
+	if !changed {
+		return changed, nil
+	}
+	bccmap.Status.Phase = constants.ConditionReady
+	return changed, r.Status().Update(ctx, bccmap)
+}
+
+func (r *BCCMapReconciler) setDegraded(ctx context.Context, bccmap *mailcowv1.BCCMap, reason, message string) (bool, error) {
+	changed := helpers.SetConditionStatus(&bccmap.Status.Conditions, constants.ConditionDegraded, reason, message, bccmap.Generation)
+	if !changed {
+		return changed, nil
+	}
+	bccmap.Status.Phase = constants.ConditionDegraded
+	return changed, r.Status().Update(ctx, bccmap)
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	mailcowv1 "github.com/tarteo/mailcow-operator/api/v1"
+	"github.com/tarteo/mailcow-operator/mailcow"
+)
+
+// MailcowClients shares one mailcow client per Mailcow resource between the reconcilers, so connections are reused
+// and the rate limits of a mailcow apply to all requests sent to it.
+type MailcowClients struct {
+	mu      sync.Mutex
+	clients map[types.UID]*sharedClient
+}
+
+type sharedClient struct {
+	name    types.NamespacedName
+	version string
+	client  *mailcow.ClientWithResponses
+}
+
+// NewMailcowClients returns an empty client registry.
+func NewMailcowClients() *MailcowClients {
+	return &MailcowClients{clients: make(map[types.UID]*sharedClient)}
+}
+
+// Get returns the client of the mailcow, it is rebuilt when the spec or one of the referenced secrets and configmaps
+// changed. The API key and the client options are only read when the client is rebuilt.
+// A nil registry returns a new client on every call.
+func (c *MailcowClients) Get(ctx context.Context, r client.Reader, res mailcowv1.MailcowInstance) (*mailcow.ClientWithResponses, error) {
+	if c == nil {
+		return res.GetClient(ctx, r)
+	}
+
+	version, err := res.GetClientVersion(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	shared, ok := c.clients[res.GetUID()]
+	if ok && shared.version == version {
+		return shared.client, nil
+	}
+
+	apiKey, _, err := res.GetAPIKey(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	opts, _, err := res.GetClientOptions(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	// The namespace of a ClusterMailcow is empty
+	name := types.NamespacedName{Name: res.GetName(), Namespace: res.GetNamespace()}
+	opts = append(opts, mailcow.WithRequestObserver(observeRequests(name)))
+	mailcowClient, err := mailcow.NewCustomClientWithResponses(res.GetMailcowSpec().Endpoint, apiKey, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		shared.client.CloseIdleConnections()
+	}
+	c.clients[res.GetUID()] = &sharedClient{
+		name:    name,
+		version: version,
+		client:  mailcowClient,
+	}
+	return mailcowClient, nil
+}
+
+// Forget removes the client of a deleted Mailcow or ClusterMailcow resource.
+func (c *MailcowClients) Forget(name types.NamespacedName) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for uid, shared := range c.clients {
+		if shared.name == name {
+			shared.client.CloseIdleConnections()
+			delete(c.clients, uid)
+		}
+	}
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	mailcowv1 "github.com/tarteo/mailcow-operator/api/v1"
+	constants "github.com/tarteo/mailcow-operator/common"
+	helpers "github.com/tarteo/mailcow-operator/helpers"
+)
+
+// ClusterMailcowReconciler reconciles a ClusterMailcow object
+type ClusterMailcowReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Clients  *MailcowClients
+	Recorder record.EventRecorder
+}
+
+// +kubebuilder:rbac:groups=mailcow.onestein.nl,resources=clustermailcows,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=mailcow.onestein.nl,resources=clustermailcows/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=mailcow.onestein.nl,resources=clustermailcows/finalizers,verbs=update
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+// Reconcile checks the health of the shared mailcow instance on a schedule and reports it in the status.
+//
+// For more details, check Reconcile and its Result here:
+// - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.19.1/pkg/reconcile
+func (r *ClusterMailcowReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := log.FromContext(ctx).WithValues("name", req.Name)
+	log.Info("reconciling clustermailcow")
+
+	var res mailcowv1.ClusterMailcow
+	if err := r.Get(ctx, req.NamespacedName, &res); err != nil {
+		if errors.IsNotFound(err) {
+			// Drop the shared client and the metrics of the deleted mailcow
+			r.Clients.Forget(req.NamespacedName)
+			forgetMailcowMetrics(req.NamespacedName)
+			return ctrl.Result{}, nil
+		}
+		log.Error(err, "unable to find clustermailcow")
+		return ctrl.Result{}, err
+	}
+
+	interval := defaultHealthCheckInterval
+	if res.Spec.HealthCheckInterval != nil && res.Spec.HealthCheckInterval.Duration > 0 {
+		interval = res.Spec.HealthCheckInterval.Duration
+	}
+
+	// Set progressing status
+	if changed, err := r.setProgressing(ctx, &res, "Checking mailcow health"); err != nil {
+		log.Error(err, "unable to set progressing status")
+		return ctrl.Result{}, err
+	} else if changed {
+		// Requeue to get fresh object with updated status
+		return ctrl.Result{Requeue: true}, nil
+	}
+
+	reason, err := r.ReconcileResource(ctx, &res)
+
+	// Always record the observed health, also when the check failed
+	now := metav1.Now()
+	res.Status.LastHealthCheck = &now
+	if err := r.Status().Update(ctx, &res); err != nil {
+		log.Error(err, "unable to update clustermailcow status")
+		return ctrl.Result{}, err
+	}
+
+	if err != nil {
+		log.Error(err, "mailcow is unhealthy")
+		changed, errStatus := r.setDegraded(ctx, &res, reason, err.Error())
+		if errStatus != nil {
+			log.Error(errStatus, "unable to set degraded status")
+			return ctrl.Result{}, errStatus
+		}
+		// Only record the problem when it changed, so every health check doesn't repeat the same event
+		if changed {
+			r.Recorder.Event(&res, corev1.EventTypeWarning, reason, err.Error())
+		}
+		// An unhealthy mailcow is an observed state, not a reconcile error, check again on the next interval
+		return ctrl.Result{RequeueAfter: interval}, nil
+	}
+
+	// Set ready status
+	if _, err := r.setReady(ctx, &res, fmt.Sprintf("Mailcow %s is healthy", res.Status.Version)); err != nil {
+		log.Error(err, "unable to set ready status")
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{RequeueAfter: interval}, nil
+}
+
+// ReconcileResource fills the status with the health of the mailcow instance.
+// When the instance is unhealthy it returns the reason and an error describing the problem.
+func (r *ClusterMailcowReconciler) ReconcileResource(ctx context.Context, res *mailcowv1.ClusterMailcow) (string, error) {
+	return checkHealth(ctx, r, r.Clients, res)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ClusterMailcowReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		// Only react to spec changes, the status is updated on every health check
+		For(&mailcowv1.ClusterMailcow{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Named("clustermailcow").
+		Complete(r)
+}
+
+func (r *ClusterMailcowReconciler) setProgressing(ctx context.Context, res *mailcowv1.ClusterMailcow, message string) (bool, error) {
+	changed := helpers.SetConditionStatus(&res.Status.Conditions, constants.ConditionProgressing, "Reconciling", message, res.Generation)
+	if !changed {
+		return changed, nil
+	}
+	res.Status.Phase = constants.ConditionProgressing
+	return changed, r.Status().Update(ctx, res)
+}
+
+func (r *ClusterMailcowReconciler) setReady(ctx context.Context, res *mailcowv1.ClusterMailcow, message string) (bool, error) {
+	changed := helpers.SetConditionStatus(&res.Status.Conditions, constants.ConditionReady, "Healthy", message, res.Generation)
+	if !changed {
+		return changed, nil
+	}
+	res.Status.Phase = constants.ConditionReady
+	return changed, r.Status().Update(ctx, res)
+}
+
+func (r *ClusterMailcowReconciler) setDegraded(ctx context.Context, res *mailcowv1.ClusterMailcow, reason, message string) (bool, error) {
+	changed := helpers.SetConditionStatus(&res.Status.Conditions, constants.ConditionDegraded, reason, message, res.Generation)
+	if !changed {
+		return changed, nil
+	}
+	res.Status.Phase = constants.ConditionDegraded
+	return changed, r.Status().Update(ctx, res)
+}
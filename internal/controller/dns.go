@@ -0,0 +1,183 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"strings"
+
+	mailcowv1 "github.com/tarteo/mailcow-operator/api/v1"
+)
+
+// txtChunkSize is the maximum length of a single character string in a TXT record.
+const txtChunkSize = 255
+
+// dnsServices are the SRV records mailcow recommends, pointing clients to the mail hostname.
+var dnsServices = []struct {
+	service string
+	port    int
+}{
+	{"_autodiscover._tcp", 443},
+	{"_caldavs._tcp", 443},
+	{"_carddavs._tcp", 443},
+	{"_imap._tcp", 143},
+	{"_imaps._tcp", 993},
+	{"_pop3._tcp", 110},
+	{"_pop3s._tcp", 995},
+	{"_sieve._tcp", 4190},
+	{"_smtps._tcp", 465},
+	{"_submission._tcp", 587},
+}
+
+// dkimKey is a DKIM key published in DNS.
+type dkimKey struct {
+	selector string
+	txt      string
+}
+
+// dnsRecords returns the DNS records recommended for the domain, DKIM keys without a selector or record are left out.
+func dnsRecords(domain *mailcowv1.Domain, mailHostname string, dkimKeys []dkimKey) []mailcowv1.DNSRecord {
+	dns := domain.Spec.DNS
+	if dns == nil {
+		dns = &mailcowv1.DomainDNS{}
+	}
+	if dns.MailHostname != "" {
+		mailHostname = dns.MailHostname
+	}
+	ttl := dns.TTL
+	if ttl == 0 {
+		ttl = 3600
+	}
+	mxPriority := dns.MXPriority
+	if mxPriority == 0 {
+		mxPriority = 10
+	}
+	spfPolicy := dns.SPFPolicy
+	if spfPolicy == "" {
+		spfPolicy = "~all"
+	}
+	dmarcPolicy := dns.DMARCPolicy
+	if dmarcPolicy == "" {
+		dmarcPolicy = "quarantine"
+	}
+
+	name := domain.Spec.Domain
+	record := func(prefix, recordType, value string) mailcowv1.DNSRecord {
+		recordName := name
+		if prefix != "" {
+			recordName = prefix + "." + name
+		}
+		return mailcowv1.DNSRecord{Name: recordName, Type: recordType, TTL: ttl, Value: value}
+	}
+
+	spf := []string{"v=spf1", "mx", "a"}
+	for _, include := range dns.SPFIncludes {
+		spf = append(spf, "include:"+include)
+	}
+	spf = append(spf, spfPolicy)
+
+	dmarc := []string{"v=DMARC1", "p=" + dmarcPolicy}
+	if len(dns.DMARCRua) > 0 {
+		dmarc = append(dmarc, "rua="+mailtoList(dns.DMARCRua))
+	}
+	if len(dns.DMARCRuf) > 0 {
+		dmarc = append(dmarc, "ruf="+mailtoList(dns.DMARCRuf))
+	}
+
+	records := []mailcowv1.DNSRecord{
+		record("", "MX", fmt.Sprintf("%d %s", mxPriority, mailHostname)),
+		record("autoconfig", "CNAME", mailHostname),
+		record("autodiscover", "CNAME", mailHostname),
+		record("", "TXT", strings.Join(spf, " ")),
+		record("_dmarc", "TXT", strings.Join(dmarc, "; ")),
+	}
+	for _, key := range dkimKeys {
+		if key.selector != "" && key.txt != "" {
+			records = append(records, record(key.selector+"._domainkey", "TXT", key.txt))
+		}
+	}
+	for _, service := range dnsServices {
+		records = append(records, record(service.service, "SRV", fmt.Sprintf("0 1 %d %s", service.port, mailHostname)))
+	}
+	records = append(records,
+		record("_caldavs._tcp", "TXT", "path=/SOGo/dav/"),
+		record("_carddavs._tcp", "TXT", "path=/SOGo/dav/"),
+	)
+	return records
+}
+
+// bindZone renders the records as a BIND zone file snippet with fully qualified names.
+func bindZone(records []mailcowv1.DNSRecord) string {
+	var zone strings.Builder
+	for _, record := range records {
+		value := record.Value
+		switch record.Type {
+		case "CNAME", "MX", "SRV":
+			// The target is the last field and must be fully qualified
+			value += "."
+		case "TXT":
+			value = quoteTXT(value)
+		}
+		fmt.Fprintf(&zone, "%s.\t%d\tIN\t%s\t%s\n", record.Name, record.TTL, record.Type, value)
+	}
+	return zone.String()
+}
+
+// quoteTXT quotes a TXT value, splitting it in character strings of at most 255 characters.
+func quoteTXT(value string) string {
+	var chunks []string
+	for len(value) > txtChunkSize {
+		chunks = append(chunks, value[:txtChunkSize])
+		value = value[txtChunkSize:]
+	}
+	chunks = append(chunks, value)
+	for i, chunk := range chunks {
+		chunks[i] = `"` + strings.ReplaceAll(chunk, `"`, `\"`) + `"`
+	}
+	return strings.Join(chunks, " ")
+}
+
+// dnsEndpoints converts the records to the endpoints of an external-dns DNSEndpoint.
+// Records with the same name and type are combined into one endpoint with multiple targets.
+func dnsEndpoints(records []mailcowv1.DNSRecord) []interface{} {
+	var endpoints []interface{}
+	index := make(map[string]map[string]interface{})
+	for _, record := range records {
+		key := record.Name + "/" + record.Type
+		if endpoint, ok := index[key]; ok {
+			endpoint["targets"] = append(endpoint["targets"].([]interface{}), record.Value)
+			continue
+		}
+		endpoint := map[string]interface{}{
+			"dnsName":    record.Name,
+			"recordType": record.Type,
+			"recordTTL":  int64(record.TTL),
+			"targets":    []interface{}{record.Value},
+		}
+		index[key] = endpoint
+		endpoints = append(endpoints, endpoint)
+	}
+	return endpoints
+}
+
+func mailtoList(addresses []string) string {
+	mailto := make([]string, len(addresses))
+	for i, address := range addresses {
+		mailto[i] = "mailto:" + address
+	}
+	return strings.Join(mailto, ",")
+}
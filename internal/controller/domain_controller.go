@@ -18,16 +18,28 @@ package controller
 
 import (
 	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/yaml"
 
 	mailcowv1 "github.com/tarteo/mailcow-operator/api/v1"
 	constants "github.com/tarteo/mailcow-operator/common"
@@ -35,16 +47,27 @@ import (
 	"github.com/tarteo/mailcow-operator/mailcow"
 )
 
+// relayHostIndex indexes domains by the name of their RelayHost, to find them when the relayhost changes.
+const relayHostIndex = ".spec.relayHost"
+
+// dnsEndpointGVK is the external-dns DNSEndpoint, used through unstructured objects so external-dns is not a dependency.
+var dnsEndpointGVK = schema.GroupVersionKind{Group: "externaldns.k8s.io", Version: "v1alpha1", Kind: "DNSEndpoint"}
+
 // DomainReconciler reconciles a Domain object
 type DomainReconciler struct {
 	client.Client
-	Scheme *runtime.Scheme
+	Scheme   *runtime.Scheme
+	Clients  *MailcowClients
+	Recorder record.EventRecorder
 }
 
 // +kubebuilder:rbac:groups=mailcow.onestein.nl,resources=domains,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=mailcow.onestein.nl,resources=domains/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=mailcow.onestein.nl,resources=domains/finalizers,verbs=update
 // +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups=externaldns.k8s.io,resources=dnsendpoints,verbs=get;list;watch;create;update;patch;delete
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -93,11 +116,16 @@ func (r *DomainReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 	if err := r.ReconcileResource(ctx, &domain); err != nil {
 		log.Error(err, "unable to reconcile mailcow domain")
 		// Set degraded status
-		if _, errStatus := r.setDegraded(ctx, &domain, err.Error()); errStatus != nil {
+		changed, errStatus := r.setDegraded(ctx, &domain, errorReason(err, "ReconcileFailed"), err.Error())
+		if errStatus != nil {
 			log.Error(errStatus, "unable to set degraded status")
 			return ctrl.Result{}, errStatus
 		}
-		return ctrl.Result{}, err
+		// Only record the error when it changed, so requeues don't repeat the same event
+		if changed {
+			recordError(r.Recorder, &domain, err)
+		}
+		return handleReconcileError(ctx, r.Client, domain.Namespace, domain.GetMailcowRef(), err)
 	}
 
 	// Remove finalizer if deletion timestamp is set
@@ -117,22 +145,59 @@ func (r *DomainReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 		return ctrl.Result{}, err
 	}
 
-	return ctrl.Result{}, nil
+	// Requeue to detect drift in mailcow, or earlier when a DKIM rotation step is due
+	requeueAfter := resyncInterval(ctx, r, domain.Namespace, domain.GetMailcowRef(), domain.Spec.ResyncInterval)
+	if next := dkimRequeueAfter(&domain, time.Now()); next > 0 && (requeueAfter == 0 || next < requeueAfter) {
+		requeueAfter = next
+	}
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
 }
 
 func (r *DomainReconciler) ReconcileResource(ctx context.Context, domain *mailcowv1.Domain) error {
 	log := log.FromContext(ctx).WithValues("namespace", types.NamespacedName{Namespace: domain.Namespace, Name: domain.Name})
 	var err error
 
+	// A domain retained by its own deletionPolicy needs no mailcow, so it can be deleted after its mailcow
+	if !domain.ObjectMeta.DeletionTimestamp.IsZero() && domain.Spec.DeletionPolicy == mailcowv1.DeletionPolicyRetain {
+		log.Info("retaining domain in mailcow")
+		return nil
+	}
+
 	// Get related mailcow resource
-	var res mailcowv1.Mailcow
-	if err := r.Get(ctx, types.NamespacedName{Name: domain.Spec.Mailcow, Namespace: domain.Namespace}, &res); err != nil {
-		log.Error(err, "unable to find related mailcow resource", "mailcow", domain.Spec.Mailcow)
+	res, err := mailcowv1.GetMailcow(ctx, r, domain.Namespace, domain.GetMailcowRef())
+	if err != nil {
+		// On deletion, e.g. during a namespace teardown, a mailcow that is gone leaves nothing to clean up
+		if !domain.ObjectMeta.DeletionTimestamp.IsZero() && errors.IsNotFound(err) {
+			log.Info("leaving domain untouched, its mailcow no longer exists")
+			return nil
+		}
+		log.Error(err, "unable to find related mailcow resource", "mailcow", domain.GetMailcowRef().String())
+		return err
+	}
+
+	// Only the oldest Domain of a domain manages it, a later claimant never touches mailcow, also not on deletion
+	owner, err := mailcowv1.FindDomain(ctx, r, domain.Namespace, domain.GetMailcowRef(), domain.Spec.Domain)
+	if err != nil {
+		log.Error(err, "unable to find the owner of the domain")
 		return err
 	}
+	if owner != nil && owner.UID != domain.UID {
+		if !domain.ObjectMeta.DeletionTimestamp.IsZero() {
+			log.Info("leaving domain managed by another Domain untouched in mailcow", "owner", owner.Namespace+"/"+owner.Name)
+			return nil
+		}
+		return &mailcowv1.DomainClaimedError{Domain: domain.Spec.Domain, Owner: owner.Namespace + "/" + owner.Name}
+	}
+
+	// Retain leaves the domain in mailcow untouched on deletion
+	deletionPolicy := res.GetDeletionPolicy(domain.Spec.DeletionPolicy)
+	if !domain.ObjectMeta.DeletionTimestamp.IsZero() && deletionPolicy == mailcowv1.DeletionPolicyRetain {
+		log.Info("retaining domain in mailcow")
+		return nil
+	}
 
 	// Create mailcow client
-	client, err := res.GetClient(ctx, r)
+	client, err := r.Clients.Get(ctx, r, res)
 	if err != nil {
 		log.Error(err, "unable to create mailcow client")
 		return err
@@ -146,18 +211,47 @@ func (r *DomainReconciler) ReconcileResource(ctx context.Context, domain *mailco
 
 	if !domain.ObjectMeta.DeletionTimestamp.IsZero() {
 		// Handle deletion
-		if response.JSON200.DomainName != nil {
+		if response.JSON200.DomainName != nil && deletionPolicy == mailcowv1.DeletionPolicyDisable {
+			active := false
+			_, err = client.UpdateDomainWithResponse(ctx, mailcow.UpdateDomainJSONRequestBody{
+				Attr:  &mailcow.EditDomainAttr{Active: &active},
+				Items: &[]string{domain.Spec.Domain},
+			})
+			if err != nil {
+				log.Error(err, "unable to disable domain")
+				return err
+			}
+			r.Recorder.Eventf(domain, corev1.EventTypeNormal, "Disabled", "Disabled domain %s in mailcow", domain.Spec.Domain)
+		} else if response.JSON200.DomainName != nil {
 			_, err = client.DeleteDomainWithResponse(ctx, mailcow.DeleteDomainJSONRequestBody{domain.Spec.Domain})
 			if err != nil {
 				log.Error(err, "unable to delete domain")
 				return err
 			}
+			r.Recorder.Eventf(domain, corev1.EventTypeNormal, "Deleted", "Deleted domain %s from mailcow", domain.Spec.Domain)
 		}
 		return nil
 	}
 
+	// Resolve the relayhost the domain sends its mail through, 0 sends it directly
+	relayhostID, err := r.relayhostID(ctx, domain)
+	if err != nil {
+		log.Error(err, "unable to resolve relayhost")
+		return err
+	}
+	// Without relayHost the relayhost in mailcow is left untouched, unless the operator set it before
+	manageRelayhost := domain.Spec.RelayHost != "" || domain.Status.RelayHostID != nil
+
+	// Created or Updated, recorded as event once the domain is saved in mailcow
+	var mutation string
 	if response.JSON200.DomainName == nil {
+		// Adopted domains are never recreated
+		if domain.Annotations[constants.AnnotationAdopt] == "true" {
+			return fmt.Errorf("adopted domain %s does not exist in mailcow", domain.Spec.Domain)
+		}
+
 		// Domain does not exist, create it
+		mutation = "Created"
 		var rlFrame = mailcow.CreateDomainJSONBodyRlFrame(domain.Spec.RateLimitFrame)
 		_, err = client.CreateDomainWithResponse(ctx, mailcow.CreateDomainJSONRequestBody{
 			Domain:      &domain.Spec.Domain,
@@ -170,29 +264,75 @@ func (r *DomainReconciler) ReconcileResource(ctx context.Context, domain *mailco
 			RlValue:     domain.Spec.RateLimit,
 			RlFrame:     &rlFrame,
 		})
+
+		if err == nil && relayhostID != 0 {
+			// The relayhost can't be set on creation
+			_, err = client.UpdateDomainWithResponse(ctx, mailcow.UpdateDomainJSONRequestBody{
+				Attr:  &mailcow.EditDomainAttr{Relayhost: helpers.Int64ToFloat32(&relayhostID)},
+				Items: &[]string{domain.Spec.Domain},
+			})
+		}
 	} else {
-		// Domain exists, update it
-		_, err = client.UpdateDomainWithResponse(ctx, mailcow.UpdateDomainJSONRequestBody{
-			Attr: &mailcow.EditDomainAttr{
+		// Domain exists, compare it against the spec
+		live := response.JSON200
+		var drifted []string
+		if helpers.BoolDrifted(domain.Spec.Active, live.Active) {
+			drifted = append(drifted, "active")
+		}
+		if helpers.StringDrifted(domain.Spec.Description, live.Description) {
+			drifted = append(drifted, "description")
+		}
+		if helpers.QuotaDrifted(&domain.Spec.Quota, live.MaxQuotaForDomain) {
+			drifted = append(drifted, "quota")
+		}
+		if helpers.QuotaDrifted(&domain.Spec.MaxQuota, live.MaxQuotaForMbox) {
+			drifted = append(drifted, "maxQuota")
+		}
+		if helpers.QuotaDrifted(&domain.Spec.DefQuota, live.DefQuotaForMbox) {
+			drifted = append(drifted, "defQuota")
+		}
+		if helpers.IntDrifted(&domain.Spec.MaxMailboxes, live.MaxNumMboxesForDomain) {
+			drifted = append(drifted, "maxMailboxes")
+		}
+		if manageRelayhost && helpers.StringDrifted(strconv.FormatInt(relayhostID, 10), live.Relayhost) {
+			drifted = append(drifted, "relayHost")
+		}
+
+		if err := recordDrift(ctx, r.Client, r.Recorder, domain, &domain.Status.Conditions, drifted); err != nil {
+			log.Error(err, "unable to record drift")
+			return err
+		}
+
+		// Rate limits are not returned by mailcow, they are only pushed when the spec changed
+		if len(drifted) > 0 || !helpers.IsReconciled(domain.Status.Conditions, domain.Generation) {
+			// Domain drifted or the spec changed, update it
+			mutation = "Updated"
+			attr := mailcow.EditDomainAttr{
 				Description: &domain.Spec.Description,
 				Quota:       helpers.Int64ToFloat32(&domain.Spec.Quota),
 				Defquota:    helpers.Int64ToFloat32(&domain.Spec.DefQuota),
 				Maxquota:    helpers.Int64ToFloat32(&domain.Spec.MaxQuota),
 				Active:      domain.Spec.Active,
 				Mailboxes:   helpers.Int64ToFloat32(&domain.Spec.MaxMailboxes),
-			},
-			Items: &[]string{domain.Spec.Domain},
-		})
-
-		if err == nil {
-			// Update rate limits, the main update endpoint doesn't handle rate limits
-			_, err = client.EditDomainRatelimits(ctx, mailcow.EditDomainRatelimitsJSONRequestBody{
-				Attr: &mailcow.EditRatelimitDomainAttr{
-					RlValue: domain.Spec.RateLimit,
-					RlFrame: &domain.Spec.RateLimitFrame,
-				},
+			}
+			if manageRelayhost {
+				attr.Relayhost = helpers.Int64ToFloat32(&relayhostID)
+			}
+			_, err = client.UpdateDomainWithResponse(ctx, mailcow.UpdateDomainJSONRequestBody{
+				Attr:  &attr,
 				Items: &[]string{domain.Spec.Domain},
 			})
+
+			if err == nil {
+				// Update rate limits, the main update endpoint doesn't handle rate limits
+				_, err = client.EditDomainRatelimits(ctx, mailcow.EditDomainRatelimitsJSONRequestBody{
+					Attr: &mailcow.EditRatelimitDomainAttr{
+						RlValue: domain.Spec.RateLimit,
+						RlFrame: &domain.Spec.RateLimitFrame,
+					},
+					Items: &[]string{domain.Spec.Domain},
+				})
+			}
 		}
 	}
 
@@ -200,119 +340,500 @@ func (r *DomainReconciler) ReconcileResource(ctx context.Context, domain *mailco
 		log.Error(err, "unable to create or update domain")
 		return err
 	}
+	if mutation != "" {
+		r.Recorder.Eventf(domain, corev1.EventTypeNormal, mutation, "%s domain %s in mailcow", mutation, domain.Spec.Domain)
+	}
+
+	// Remember the relayhost that was set, so it is cleared again when relayHost is removed
+	var statusRelayhostID *int
+	if domain.Spec.RelayHost != "" {
+		id := int(relayhostID)
+		statusRelayhostID = &id
+	}
+	if !helpers.IntPtrEqual(domain.Status.RelayHostID, statusRelayhostID) {
+		domain.Status.RelayHostID = statusRelayhostID
+		if err := r.Status().Update(ctx, domain); err != nil {
+			log.Error(err, "unable to update domain relayhost status")
+			return err
+		}
+	}
 
 	// Reconcile DKIM
-	if err := r.reconcileDKIM(ctx, client, domain); err != nil {
+	dkim, err := r.reconcileDKIM(ctx, client, domain)
+	if err != nil {
 		log.Error(err, "unable to reconcile DKIM")
 		return err
 	}
 
+	// Reconcile DNS records
+	if err := r.reconcileDNS(ctx, res, domain, dkim); err != nil {
+		log.Error(err, "unable to reconcile DNS records")
+		return err
+	}
+
 	return nil
 }
 
-// reconcileDKIM handles DKIM key retrieval/generation and ConfigMap creation
-func (r *DomainReconciler) reconcileDKIM(ctx context.Context, client *mailcow.ClientWithResponses, domain *mailcowv1.Domain) error {
+// reconcileDKIM handles DKIM key retrieval/generation and rotation, and returns the DKIM data for the ConfigMap
+//
+// mailcow holds a single DKIM key per domain, so a rotation generates the new key in the operator and keeps it in
+// a Secret. The new key is published next to the current key for the rotation overlap, after which the current key
+// is deleted from mailcow and the new key is imported.
+func (r *DomainReconciler) reconcileDKIM(ctx context.Context, client *mailcow.ClientWithResponses, domain *mailcowv1.Domain) (map[string]string, error) {
 	log := log.FromContext(ctx).WithValues("namespace", types.NamespacedName{Namespace: domain.Namespace, Name: domain.Name})
 
+	spec := mailcowv1.DomainDKIM{}
+	if domain.Spec.DKIM != nil {
+		spec = *domain.Spec.DKIM
+	}
+	if spec.Selector == "" {
+		spec.Selector = "dkim"
+	}
+	if spec.KeySize == 0 {
+		spec.KeySize = 2048
+	}
+	overlap := dkimRotationOverlap(domain)
+
+	status := mailcowv1.DKIMStatus{}
+	if domain.Status.DKIM != nil {
+		status = *domain.Status.DKIM.DeepCopy()
+	}
+
+	// A rotation in progress keeps the new key in a Secret
+	var pendingSecret corev1.Secret
+	pending := true
+	if err := r.Get(ctx, types.NamespacedName{Name: dkimPendingSecretName(domain), Namespace: domain.Namespace}, &pendingSecret); err != nil {
+		if !errors.IsNotFound(err) {
+			log.Error(err, "unable to get pending DKIM key")
+			return nil, err
+		}
+		pending = false
+	}
+
 	// Try to get existing DKIM key
 	dkimResponse, err := client.GetDKIMKeyWithResponse(ctx, domain.Spec.Domain, nil)
 	if err != nil {
 		log.Error(err, "unable to get DKIM key")
-		return err
+		return nil, err
 	}
+	exists := dkimResponse.JSON200 != nil && dkimResponse.JSON200.DkimTxt != nil
+
+	now := metav1.Now()
+	switch {
+	case pending && (!exists || now.Sub(pendingSecret.CreationTimestamp.Time) >= overlap):
+		// The overlap passed, or a previous switch was interrupted after deleting the current key
+		if err := r.switchDKIMKey(ctx, client, domain, &pendingSecret, exists); err != nil {
+			return nil, err
+		}
+		status.LastRotation = &now
+		status.PendingSelector = ""
+		status.PendingSince = nil
+		pending = false
+		// Mail signed with the replaced key may still be in transit, its record stays published for another overlap
+		if exists && dkimResponse.JSON200.DkimSelector != nil {
+			status.PreviousSelector = *dkimResponse.JSON200.DkimSelector
+			status.PreviousTxt = *dkimResponse.JSON200.DkimTxt
+			status.PreviousUntil = &metav1.Time{Time: now.Add(overlap)}
+		}
 
-	var dkimGetResponse *mailcow.GetDKIMKeyResponse
+		dkimResponse, err = client.GetDKIMKeyWithResponse(ctx, domain.Spec.Domain, nil)
+		if err != nil {
+			log.Error(err, "unable to retrieve rotated DKIM key")
+			return nil, err
+		}
+		if dkimResponse.JSON200 == nil || dkimResponse.JSON200.DkimSelector == nil || *dkimResponse.JSON200.DkimSelector != string(pendingSecret.Data["selector"]) {
+			return nil, fmt.Errorf("DKIM key with selector %s was not imported into mailcow", pendingSecret.Data["selector"])
+		}
+		if err := r.Delete(ctx, &pendingSecret); err != nil && !errors.IsNotFound(err) {
+			log.Error(err, "unable to delete pending DKIM key")
+			return nil, err
+		}
+		r.Recorder.Eventf(domain, corev1.EventTypeNormal, "DKIMRotated", "Switched to DKIM key with selector %s", pendingSecret.Data["selector"])
 
-	// If DKIM key doesn't exist, generate one
-	if dkimResponse.JSON200 == nil || dkimResponse.JSON200.DkimTxt == nil {
+	case !exists:
+		// If DKIM key doesn't exist, generate one
 		log.Info("generating new DKIM key")
-		keySize := float32(2048)
-		selector := "dkim"
+		keySize := float32(spec.KeySize)
 		_, err := client.GenerateDKIMKeyWithResponse(ctx, mailcow.GenerateDKIMKeyJSONRequestBody{
 			Domains:      &domain.Spec.Domain,
 			KeySize:      &keySize,
-			DkimSelector: &selector,
+			DkimSelector: &spec.Selector,
 		})
 		if err != nil {
 			log.Error(err, "unable to generate DKIM key")
-			return err
+			return nil, err
 		}
+		status.LastRotation = &now
+		r.Recorder.Eventf(domain, corev1.EventTypeNormal, "DKIMGenerated", "Generated %d bit DKIM key with selector %s", spec.KeySize, spec.Selector)
 
 		// Retrieve the newly generated DKIM key
 		dkimResponse, err = client.GetDKIMKeyWithResponse(ctx, domain.Spec.Domain, nil)
 		if err != nil {
 			log.Error(err, "unable to retrieve generated DKIM key")
-			return err
+			return nil, err
 		}
-		dkimGetResponse = dkimResponse
-	} else {
-		dkimGetResponse = dkimResponse
-	}
 
-	dkimData := dkimGetResponse.JSON200
-
-	// Create ConfigMap with DKIM data
-	if dkimData != nil {
-		configMap := &corev1.ConfigMap{
+	case !pending && dkimRotationDue(&spec, &status, dkimResponse.JSON200.DkimSelector, dkimResponse.JSON200.Length, now.Time):
+		// Publish a new key ahead of the switch
+		selector := spec.Selector + "-" + now.Format("20060102")
+		if dkimResponse.JSON200.DkimSelector != nil && *dkimResponse.JSON200.DkimSelector == selector {
+			selector = spec.Selector + "-" + now.Format("20060102150405")
+		}
+		log.Info("starting DKIM key rotation", "selector", selector)
+		privateKey, err := helpers.GenerateDKIMKey(int(spec.KeySize))
+		if err != nil {
+			return nil, err
+		}
+		pendingSecret = corev1.Secret{
 			ObjectMeta: metav1.ObjectMeta{
-				Name:      "dkim-" + domain.Name,
+				Name:      dkimPendingSecretName(domain),
 				Namespace: domain.Namespace,
 				OwnerReferences: []metav1.OwnerReference{
 					*metav1.NewControllerRef(domain, mailcowv1.GroupVersion.WithKind("Domain")),
 				},
 			},
-			Data: make(map[string]string),
+			Type: corev1.SecretTypeOpaque,
+			Data: map[string][]byte{
+				"selector": []byte(selector),
+				"privkey":  []byte(privateKey),
+			},
 		}
+		if err := r.Create(ctx, &pendingSecret); err != nil {
+			log.Error(err, "unable to create pending DKIM key")
+			return nil, err
+		}
+		pending = true
+		r.Recorder.Eventf(domain, corev1.EventTypeNormal, "DKIMRotationStarted", "Publishing DKIM key with selector %s, switching after %s", selector, overlap)
+	}
 
+	dkimData := dkimResponse.JSON200
+	data := make(map[string]string)
+	if dkimData != nil {
 		if dkimData.DkimSelector != nil {
-			configMap.Data["selector"] = *dkimData.DkimSelector
+			data["selector"] = *dkimData.DkimSelector
+			status.Selector = *dkimData.DkimSelector
 		}
 		if dkimData.DkimTxt != nil {
-			configMap.Data["txt"] = *dkimData.DkimTxt
+			data["txt"] = *dkimData.DkimTxt
 		}
 		if dkimData.Length != nil {
-			configMap.Data["length"] = *dkimData.Length
+			data["length"] = *dkimData.Length
+			if length, err := strconv.Atoi(*dkimData.Length); err == nil {
+				status.KeySize = int32(length)
+			}
 		}
 		if dkimData.Pubkey != nil {
-			configMap.Data["pubkey"] = *dkimData.Pubkey
-		}
-
-		// Try to get existing ConfigMap
-		var existingConfigMap corev1.ConfigMap
-		if err := r.Get(ctx, types.NamespacedName{Name: configMap.Name, Namespace: configMap.Namespace}, &existingConfigMap); err != nil {
-			if errors.IsNotFound(err) {
-				// Create new ConfigMap
-				if err := r.Create(ctx, configMap); err != nil {
-					log.Error(err, "unable to create ConfigMap")
-					return err
-				}
-				log.Info("created DKIM ConfigMap")
-			} else {
-				log.Error(err, "unable to get ConfigMap")
+			data["pubkey"] = *dkimData.Pubkey
+		}
+	}
+	// Keys that existed before the operator count from the moment they were first seen
+	if status.LastRotation == nil {
+		status.LastRotation = &now
+	}
+
+	if status.PreviousUntil != nil && now.Before(status.PreviousUntil) {
+		data["previousSelector"] = status.PreviousSelector
+		data["previousTxt"] = status.PreviousTxt
+	} else {
+		status.PreviousSelector = ""
+		status.PreviousTxt = ""
+		status.PreviousUntil = nil
+	}
+
+	if pending {
+		txt, err := helpers.DKIMTxt(string(pendingSecret.Data["privkey"]))
+		if err != nil {
+			log.Error(err, "unable to read pending DKIM key")
+			return nil, err
+		}
+		data["pendingSelector"] = string(pendingSecret.Data["selector"])
+		data["pendingTxt"] = txt
+		status.PendingSelector = string(pendingSecret.Data["selector"])
+		status.PendingSince = &pendingSecret.CreationTimestamp
+	}
+
+	if !equality.Semantic.DeepEqual(domain.Status.DKIM, &status) {
+		domain.Status.DKIM = &status
+		if err := r.Status().Update(ctx, domain); err != nil {
+			log.Error(err, "unable to update domain DKIM status")
+			return nil, err
+		}
+	}
+
+	return data, nil
+}
+
+// switchDKIMKey replaces the DKIM key in mailcow with the pending key.
+func (r *DomainReconciler) switchDKIMKey(ctx context.Context, client *mailcow.ClientWithResponses, domain *mailcowv1.Domain, pendingSecret *corev1.Secret, exists bool) error {
+	log := log.FromContext(ctx).WithValues("namespace", types.NamespacedName{Namespace: domain.Namespace, Name: domain.Name})
+
+	selector := string(pendingSecret.Data["selector"])
+	privateKey := string(pendingSecret.Data["privkey"])
+	log.Info("switching DKIM key", "selector", selector)
+
+	if exists {
+		if _, err := client.DeleteDKIMKeyWithResponse(ctx, mailcow.DeleteDKIMKeyJSONRequestBody{domain.Spec.Domain}); err != nil {
+			log.Error(err, "unable to delete DKIM key")
+			return err
+		}
+	}
+
+	if _, err := client.ImportDKIMKeyWithResponse(ctx, mailcow.ImportDKIMKeyJSONRequestBody{
+		Domain:         &domain.Spec.Domain,
+		DkimSelector:   &selector,
+		PrivateKeyFile: &privateKey,
+	}); err != nil {
+		log.Error(err, "unable to import DKIM key")
+		return err
+	}
+	return nil
+}
+
+// dkimRotationDue returns whether the current key has to be replaced, because it expired or doesn't match the spec.
+func dkimRotationDue(spec *mailcowv1.DomainDKIM, status *mailcowv1.DKIMStatus, selector, length *string, now time.Time) bool {
+	if spec.RotationPeriod != nil && spec.RotationPeriod.Duration > 0 && status.LastRotation != nil && now.Sub(status.LastRotation.Time) >= spec.RotationPeriod.Duration {
+		return true
+	}
+	if length != nil && *length != strconv.Itoa(int(spec.KeySize)) {
+		return true
+	}
+	if selector != nil && *selector != spec.Selector && !strings.HasPrefix(*selector, spec.Selector+"-") {
+		return true
+	}
+	return false
+}
+
+// dkimRotationOverlap returns how long a new DKIM key is published before the domain switches to it.
+func dkimRotationOverlap(domain *mailcowv1.Domain) time.Duration {
+	if domain.Spec.DKIM != nil && domain.Spec.DKIM.RotationOverlap != nil {
+		return domain.Spec.DKIM.RotationOverlap.Duration
+	}
+	return 48 * time.Hour
+}
+
+// dkimRequeueAfter returns the time until the overlap of a pending DKIM key ends, the record of a replaced key is
+// removed or the next rotation is due, 0 when nothing is scheduled. These are independent of the resync interval.
+func dkimRequeueAfter(domain *mailcowv1.Domain, now time.Time) time.Duration {
+	status := domain.Status.DKIM
+	if status == nil {
+		return 0
+	}
+
+	var next time.Time
+	switch {
+	case status.PendingSince != nil:
+		next = status.PendingSince.Add(dkimRotationOverlap(domain))
+	case domain.Spec.DKIM != nil && domain.Spec.DKIM.RotationPeriod != nil && domain.Spec.DKIM.RotationPeriod.Duration > 0 && status.LastRotation != nil:
+		next = status.LastRotation.Add(domain.Spec.DKIM.RotationPeriod.Duration)
+	}
+	if status.PreviousUntil != nil && (next.IsZero() || status.PreviousUntil.Time.Before(next)) {
+		next = status.PreviousUntil.Time
+	}
+	if next.IsZero() {
+		return 0
+	}
+
+	// A step that is already due is retried shortly, instead of requeueing immediately
+	if until := next.Sub(now); until > time.Second {
+		return until
+	}
+	return time.Second
+}
+
+// dkimPendingSecretName returns the name of the Secret holding the new DKIM key during a rotation.
+func dkimPendingSecretName(domain *mailcowv1.Domain) string {
+	return "dkim-" + domain.Name + "-pending"
+}
+
+// reconcileDNS renders the recommended DNS records into the status and, with the DKIM data, into the ConfigMap
+func (r *DomainReconciler) reconcileDNS(ctx context.Context, res mailcowv1.MailcowInstance, domain *mailcowv1.Domain, dkim map[string]string) error {
+	log := log.FromContext(ctx).WithValues("namespace", types.NamespacedName{Namespace: domain.Namespace, Name: domain.Name})
+
+	records := dnsRecords(domain, res.GetHostname(), []dkimKey{
+		{selector: dkim["selector"], txt: dkim["txt"]},
+		{selector: dkim["pendingSelector"], txt: dkim["pendingTxt"]},
+		{selector: dkim["previousSelector"], txt: dkim["previousTxt"]},
+	})
+	recordsYAML, err := yaml.Marshal(records)
+	if err != nil {
+		return err
+	}
+
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "dkim-" + domain.Name,
+			Namespace: domain.Namespace,
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(domain, mailcowv1.GroupVersion.WithKind("Domain")),
+			},
+		},
+		Data: dkim,
+	}
+	configMap.Data["records"] = string(recordsYAML)
+	configMap.Data["zone"] = bindZone(records)
+
+	// Try to get existing ConfigMap
+	var existingConfigMap corev1.ConfigMap
+	if err := r.Get(ctx, types.NamespacedName{Name: configMap.Name, Namespace: configMap.Namespace}, &existingConfigMap); err != nil {
+		if errors.IsNotFound(err) {
+			// Create new ConfigMap
+			if err := r.Create(ctx, configMap); err != nil {
+				log.Error(err, "unable to create ConfigMap")
 				return err
 			}
+			log.Info("created DKIM ConfigMap")
 		} else {
-			// Update existing ConfigMap
-			existingConfigMap.Data = configMap.Data
-			if err := r.Update(ctx, &existingConfigMap); err != nil {
-				log.Error(err, "unable to update ConfigMap")
+			log.Error(err, "unable to get ConfigMap")
+			return err
+		}
+	} else if !equality.Semantic.DeepEqual(existingConfigMap.Data, configMap.Data) {
+		// Update existing ConfigMap
+		existingConfigMap.Data = configMap.Data
+		if err := r.Update(ctx, &existingConfigMap); err != nil {
+			log.Error(err, "unable to update ConfigMap")
+			return err
+		}
+		log.Info("updated DKIM ConfigMap")
+	}
+
+	if !equality.Semantic.DeepEqual(domain.Status.DNSRecords, records) {
+		domain.Status.DNSRecords = records
+		if err := r.Status().Update(ctx, domain); err != nil {
+			log.Error(err, "unable to update domain DNS records")
+			return err
+		}
+	}
+
+	if err := r.reconcileDNSEndpoint(ctx, domain, records); err != nil {
+		log.Error(err, "unable to reconcile DNSEndpoint")
+		return err
+	}
+
+	return nil
+}
+
+// reconcileDNSEndpoint publishes the records through an external-dns DNSEndpoint, or removes it when disabled
+func (r *DomainReconciler) reconcileDNSEndpoint(ctx context.Context, domain *mailcowv1.Domain, records []mailcowv1.DNSRecord) error {
+	log := log.FromContext(ctx).WithValues("namespace", types.NamespacedName{Namespace: domain.Namespace, Name: domain.Name})
+
+	var externalDNS *mailcowv1.ExternalDNS
+	if domain.Spec.DNS != nil {
+		externalDNS = domain.Spec.DNS.ExternalDNS
+	}
+
+	existing := &unstructured.Unstructured{}
+	existing.SetGroupVersionKind(dnsEndpointGVK)
+	err := r.Get(ctx, types.NamespacedName{Name: domain.Name, Namespace: domain.Namespace}, existing)
+	if meta.IsNoMatchError(err) {
+		if externalDNS == nil || !externalDNS.Enabled {
+			return nil
+		}
+		return fmt.Errorf("the DNSEndpoint CRD of external-dns is not installed: %w", err)
+	}
+	if err != nil && !errors.IsNotFound(err) {
+		log.Error(err, "unable to get DNSEndpoint")
+		return err
+	}
+	found := err == nil
+
+	if externalDNS == nil || !externalDNS.Enabled {
+		if found && metav1.IsControlledBy(existing, domain) {
+			if err := r.Delete(ctx, existing); err != nil && !errors.IsNotFound(err) {
+				log.Error(err, "unable to delete DNSEndpoint")
 				return err
 			}
-			log.Info("updated DKIM ConfigMap")
+			log.Info("deleted DNSEndpoint")
 		}
+		return nil
+	}
+
+	endpoint := &unstructured.Unstructured{}
+	endpoint.SetGroupVersionKind(dnsEndpointGVK)
+	endpoint.SetName(domain.Name)
+	endpoint.SetNamespace(domain.Namespace)
+	endpoint.SetLabels(externalDNS.Labels)
+	endpoint.SetOwnerReferences([]metav1.OwnerReference{
+		*metav1.NewControllerRef(domain, mailcowv1.GroupVersion.WithKind("Domain")),
+	})
+	if err := unstructured.SetNestedSlice(endpoint.Object, dnsEndpoints(records), "spec", "endpoints"); err != nil {
+		return err
 	}
 
+	if !found {
+		if err := r.Create(ctx, endpoint); err != nil {
+			log.Error(err, "unable to create DNSEndpoint")
+			return err
+		}
+		log.Info("created DNSEndpoint")
+		return nil
+	}
+
+	if !metav1.IsControlledBy(existing, domain) {
+		return fmt.Errorf("DNSEndpoint %s already exists and is not owned by the domain", domain.Name)
+	}
+	if equality.Semantic.DeepEqual(existing.Object["spec"], endpoint.Object["spec"]) && equality.Semantic.DeepEqual(existing.GetLabels(), endpoint.GetLabels()) {
+		return nil
+	}
+	existing.Object["spec"] = endpoint.Object["spec"]
+	existing.SetLabels(endpoint.GetLabels())
+	if err := r.Update(ctx, existing); err != nil {
+		log.Error(err, "unable to update DNSEndpoint")
+		return err
+	}
+	log.Info("updated DNSEndpoint")
 	return nil
 }
 
+// relayhostID returns the mailcow id of the RelayHost of the domain, or 0 when the domain has no relayHost.
+func (r *DomainReconciler) relayhostID(ctx context.Context, domain *mailcowv1.Domain) (int64, error) {
+	if domain.Spec.RelayHost == "" {
+		return 0, nil
+	}
+
+	var relayhost mailcowv1.RelayHost
+	if err := r.Get(ctx, types.NamespacedName{Name: domain.Spec.RelayHost, Namespace: domain.Namespace}, &relayhost); err != nil {
+		return 0, err
+	}
+	// The webhook checks the mailcow too, but it can be disabled, an id of another mailcow must never be written
+	if relayhost.GetMailcowRef() != domain.GetMailcowRef() {
+		return 0, &mailcowMismatchError{Kind: "RelayHost", Name: relayhost.Name, Mailcow: relayhost.GetMailcowRef()}
+	}
+	if relayhost.Status.ID == nil {
+		return 0, fmt.Errorf("RelayHost `%s` is not ready", relayhost.Name)
+	}
+	return int64(*relayhost.Status.ID), nil
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *DomainReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &mailcowv1.Domain{}, relayHostIndex, func(obj client.Object) []string {
+		return []string{obj.(*mailcowv1.Domain).Spec.RelayHost}
+	}); err != nil {
+		return err
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&mailcowv1.Domain{}).
+		Watches(&mailcowv1.RelayHost{}, handler.EnqueueRequestsFromMapFunc(r.findDomainsForRelayHost)).
 		Named("domain").
 		Complete(r)
 }
 
+// findDomainsForRelayHost returns a request for every domain that sends its mail through the relayhost.
+func (r *DomainReconciler) findDomainsForRelayHost(ctx context.Context, relayhost client.Object) []reconcile.Request {
+	var domains mailcowv1.DomainList
+	if err := r.List(ctx, &domains, client.InNamespace(relayhost.GetNamespace()), client.MatchingFields{relayHostIndex: relayhost.GetName()}); err != nil {
+		log.FromContext(ctx).Error(err, "unable to list domains for relayhost", "relayhost", relayhost.GetName())
+		return nil
+	}
+
+	requests := make([]reconcile.Request, len(domains.Items))
+	for i, domain := range domains.Items {
+		requests[i] = reconcile.Request{NamespacedName: types.NamespacedName{Name: domain.Name, Namespace: domain.Namespace}}
+	}
+	return requests
+}
+
 func (r *DomainReconciler) setProgressing(ctx context.Context, domain *mailcowv1.Domain, message string) (bool, error) {
 	changed := helpers.SetConditionStatus(&domain.Status.Conditions, constants.ConditionProgressing, "Reconciling", message, domain.Generation)
 	if !changed {
@@ -331,8 +852,8 @@ func (r *DomainReconciler) setReady(ctx context.Context, domain *mailcowv1.Domai
 	return changed, r.Status().Update(ctx, domain)
 }
 
-func (r *DomainReconciler) setDegraded(ctx context.Context, domain *mailcowv1.Domain, message string) (bool, error) {
-	changed := helpers.SetConditionStatus(&domain.Status.Conditions, constants.ConditionDegraded, "ReconcileFailed", message, domain.Generation)
+func (r *DomainReconciler) setDegraded(ctx context.Context, domain *mailcowv1.Domain, reason, message string) (bool, error) {
+	changed := helpers.SetConditionStatus(&domain.Status.Conditions, constants.ConditionDegraded, reason, message, domain.Generation)
 	if !changed {
 		return changed, nil
 	}
@@ -18,14 +18,19 @@ package controller
 
 import (
 	"context"
+	"fmt"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	mailcowv1 "github.com/tarteo/mailcow-operator/api/v1"
 	constants "github.com/tarteo/mailcow-operator/common"
@@ -36,13 +41,16 @@ import (
 // DomainAdminReconciler reconciles a DomainAdmin object
 type DomainAdminReconciler struct {
 	client.Client
-	Scheme *runtime.Scheme
+	Scheme   *runtime.Scheme
+	Clients  *MailcowClients
+	Recorder record.EventRecorder
 }
 
 // +kubebuilder:rbac:groups=mailcow.onestein.nl,resources=domainadmins,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=mailcow.onestein.nl,resources=domainadmins/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=mailcow.onestein.nl,resources=domainadmins/finalizers,verbs=update
-// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;update;patch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -91,11 +99,16 @@ func (r *DomainAdminReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	if err := r.ReconcileResource(ctx, &domainadmin); err != nil {
 		log.Error(err, "unable to reconcile mailcow domainadmin")
 		// Set degraded status
-		if _, errStatus := r.setDegraded(ctx, &domainadmin, err.Error()); errStatus != nil {
+		changed, errStatus := r.setDegraded(ctx, &domainadmin, errorReason(err, "ReconcileFailed"), err.Error())
+		if errStatus != nil {
 			log.Error(errStatus, "unable to set degraded status")
 			return ctrl.Result{}, errStatus
 		}
-		return ctrl.Result{}, err
+		// Only record the error when it changed, so requeues don't repeat the same event
+		if changed {
+			recordError(r.Recorder, &domainadmin, err)
+		}
+		return handleReconcileError(ctx, r.Client, domainadmin.Namespace, domainadmin.GetMailcowRef(), err)
 	}
 
 	// Remove finalizer if deletion timestamp is set
@@ -115,22 +128,51 @@ func (r *DomainAdminReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return ctrl.Result{}, err
 	}
 
-	return ctrl.Result{}, nil
+	// Requeue to detect drift in mailcow
+	return ctrl.Result{RequeueAfter: resyncInterval(ctx, r, domainadmin.Namespace, domainadmin.GetMailcowRef(), domainadmin.Spec.ResyncInterval)}, nil
 }
 
 func (r *DomainAdminReconciler) ReconcileResource(ctx context.Context, domainadmin *mailcowv1.DomainAdmin) error {
 	log := log.FromContext(ctx).WithValues("namespace", types.NamespacedName{Namespace: domainadmin.Namespace, Name: domainadmin.Name})
 	var err error
 
+	// A domainadmin retained by its own deletionPolicy needs no mailcow, so it can be deleted after its mailcow
+	if !domainadmin.ObjectMeta.DeletionTimestamp.IsZero() && domainadmin.Spec.DeletionPolicy == mailcowv1.DeletionPolicyRetain {
+		log.Info("retaining domainadmin in mailcow")
+		return releasePasswordSecret(ctx, r.Client, r.Recorder, domainadmin, domainadmin.Spec.PasswordSecret)
+	}
+
 	// Get related mailcow resource
-	var res mailcowv1.Mailcow
-	if err := r.Get(ctx, types.NamespacedName{Name: domainadmin.Spec.Mailcow, Namespace: domainadmin.Namespace}, &res); err != nil {
-		log.Error(err, "unable to find related mailcow resource", "mailcow", domainadmin.Spec.Mailcow)
+	res, err := mailcowv1.GetMailcow(ctx, r, domainadmin.Namespace, domainadmin.GetMailcowRef())
+	if err != nil {
+		// On deletion, e.g. during a namespace teardown, a mailcow that is gone leaves nothing to clean up
+		if !domainadmin.ObjectMeta.DeletionTimestamp.IsZero() && errors.IsNotFound(err) {
+			log.Info("leaving domainadmin untouched, its mailcow no longer exists")
+			return releasePasswordSecret(ctx, r.Client, r.Recorder, domainadmin, domainadmin.Spec.PasswordSecret)
+		}
+		log.Error(err, "unable to find related mailcow resource", "mailcow", domainadmin.GetMailcowRef().String())
 		return err
 	}
 
+	// Objects in a domain of another namespace are never touched in mailcow, also not on deletion
+	if err := checkDomainOwnership(ctx, r, domainadmin.Namespace, domainadmin.GetMailcowRef(), domainadmin.Spec.Domains...); err != nil {
+		if !domainadmin.ObjectMeta.DeletionTimestamp.IsZero() && isDomainNotAllowed(err) {
+			log.Info("leaving domainadmin in a domain not owned by the namespace untouched in mailcow")
+			return releasePasswordSecret(ctx, r.Client, r.Recorder, domainadmin, domainadmin.Spec.PasswordSecret)
+		}
+		log.Error(err, "unable to use the domain of the domainadmin")
+		return err
+	}
+
+	// Retain leaves the domainadmin in mailcow untouched on deletion
+	deletionPolicy := res.GetDeletionPolicy(domainadmin.Spec.DeletionPolicy)
+	if !domainadmin.ObjectMeta.DeletionTimestamp.IsZero() && deletionPolicy == mailcowv1.DeletionPolicyRetain {
+		log.Info("retaining domainadmin in mailcow")
+		return releasePasswordSecret(ctx, r.Client, r.Recorder, domainadmin, domainadmin.Spec.PasswordSecret)
+	}
+
 	// Create mailcow client
-	client, err := res.GetClient(ctx, r)
+	client, err := r.Clients.Get(ctx, r, res)
 	if err != nil {
 		log.Error(err, "unable to create mailcow client")
 		return err
@@ -156,10 +198,14 @@ func (r *DomainAdminReconciler) ReconcileResource(ctx context.Context, domainadm
 
 	// Find if the domain admin already exists
 	var domainAdminExists bool
+	var liveActive *int
+	var liveDomains *[]string
 	if parsedResponse != nil {
 		for _, da := range *parsedResponse.JSON200 {
 			if da.Username != nil && *da.Username == domainadmin.Spec.Username {
 				domainAdminExists = true
+				liveActive = da.Active
+				liveDomains = da.SelectedDomains
 				break
 			}
 		}
@@ -167,24 +213,57 @@ func (r *DomainAdminReconciler) ReconcileResource(ctx context.Context, domainadm
 
 	if !domainadmin.ObjectMeta.DeletionTimestamp.IsZero() {
 		// Handle deletion
-		if domainAdminExists {
+		if domainAdminExists && deletionPolicy == mailcowv1.DeletionPolicyDisable {
+			active := false
+			_, err = client.EditDomainAdminUserWithResponse(ctx, mailcow.EditDomainAdminUserJSONRequestBody{
+				Attr:  &mailcow.EditDomainAdminAttr{Active: &active},
+				Items: &[]string{domainadmin.Spec.Username},
+			})
+			if err != nil {
+				log.Error(err, "unable to disable domainadmin")
+				return err
+			}
+			r.Recorder.Eventf(domainadmin, corev1.EventTypeNormal, "Disabled", "Disabled domain admin %s in mailcow", domainadmin.Spec.Username)
+		} else if domainAdminExists {
 			_, err = client.DeleteDomainAdminWithResponse(ctx, mailcow.DeleteDomainAdminJSONRequestBody{domainadmin.Spec.Username})
 			if err != nil {
 				log.Error(err, "unable to delete domainadmin")
 				return err
 			}
+			r.Recorder.Eventf(domainadmin, corev1.EventTypeNormal, "Deleted", "Deleted domain admin %s from mailcow", domainadmin.Spec.Username)
+		}
+		// The generated password of a disabled domainadmin is kept
+		if deletionPolicy == mailcowv1.DeletionPolicyDisable {
+			return releasePasswordSecret(ctx, r.Client, r.Recorder, domainadmin, domainadmin.Spec.PasswordSecret)
 		}
 		return nil
 	}
 
-	// Get password from secret
-	password, err := domainadmin.GetPassword(ctx, r)
+	// Generate the password secret when it doesn't exist, otherwise get the password from the secret
+	password, err := ensurePasswordSecret(ctx, r.Client, r.Recorder, domainadmin, "DomainAdmin", domainadmin.Spec.PasswordSecret, domainadmin.Spec.GeneratePassword)
+	if err != nil {
+		log.Error(err, "unable to generate password secret")
+		return err
+	}
+	if password == "" {
+		password, err = domainadmin.GetPassword(ctx, r)
+	}
 	if err != nil {
 		log.Error(err, "unable to get password from secret")
 		return err
 	}
 
+	// Salt with the uid, so the hash in the status can't be compared across resources
+	passwordHash := helpers.Hash(string(domainadmin.UID), password)
+
+	adopted := domainadmin.Annotations[constants.AnnotationAdopt] == "true"
+
 	if !domainAdminExists {
+		// Adopted domain admins are never recreated
+		if adopted {
+			return fmt.Errorf("adopted domainadmin %s does not exist in mailcow", domainadmin.Spec.Username)
+		}
+
 		// DomainAdmin does not exist, create it
 		_, err = client.CreateDomainAdminUserWithResponse(ctx, mailcow.CreateDomainAdminUserJSONRequestBody{
 			Username:  &domainadmin.Spec.Username,
@@ -198,14 +277,41 @@ func (r *DomainAdminReconciler) ReconcileResource(ctx context.Context, domainadm
 			log.Error(err, "unable to create domainadmin")
 			return err
 		}
+		r.Recorder.Eventf(domainadmin, corev1.EventTypeNormal, "Created", "Created domain admin %s in mailcow", domainadmin.Spec.Username)
 	} else {
-		// DomainAdmin exists, update it
+		// DomainAdmin exists, compare it against the spec
+		var drifted []string
+		if helpers.BoolDrifted(domainadmin.Spec.Active, liveActive) {
+			drifted = append(drifted, "active")
+		}
+		if liveDomains != nil && helpers.ListDrifted(domainadmin.Spec.Domains, *liveDomains) {
+			drifted = append(drifted, "domains")
+		}
+
+		if err := recordDrift(ctx, r.Client, r.Recorder, domainadmin, &domainadmin.Status.Conditions, drifted); err != nil {
+			log.Error(err, "unable to record drift")
+			return err
+		}
+
+		// The password of an adopted domain admin is unknown, it is only pushed once the secret is rotated
+		passwordChanged := domainadmin.Status.PasswordHash != passwordHash && !(adopted && domainadmin.Status.PasswordHash == "")
+		if len(drifted) == 0 && !passwordChanged && helpers.IsReconciled(domainadmin.Status.Conditions, domainadmin.Generation) {
+			return nil
+		}
+
+		// DomainAdmin drifted or the spec changed, update it
+		attr := mailcow.EditDomainAdminAttr{
+			Active:  domainadmin.Spec.Active,
+			Domains: &domainadmin.Spec.Domains,
+		}
+		// Only push the password when the secret changed, so a password is not reset on every reconcile
+		if passwordChanged {
+			log.Info("password changed, updating domainadmin password")
+			attr.Password = &password
+			attr.Password2 = &password
+		}
 		_, err = client.EditDomainAdminUserWithResponse(ctx, mailcow.EditDomainAdminUserJSONRequestBody{
-			Attr: &mailcow.EditDomainAdminAttr{
-				// Password: &password, // Should we always update the password? assuming someone might want to change it
-				Active:  domainadmin.Spec.Active,
-				Domains: &domainadmin.Spec.Domains,
-			},
+			Attr:  &attr,
 			Items: &[]string{domainadmin.Spec.Username},
 		})
 
@@ -213,6 +319,15 @@ func (r *DomainAdminReconciler) ReconcileResource(ctx context.Context, domainadm
 			log.Error(err, "unable to update domainadmin")
 			return err
 		}
+		r.Recorder.Eventf(domainadmin, corev1.EventTypeNormal, "Updated", "Updated domain admin %s in mailcow", domainadmin.Spec.Username)
+	}
+
+	if domainadmin.Status.PasswordHash != passwordHash {
+		domainadmin.Status.PasswordHash = passwordHash
+		if err := r.Status().Update(ctx, domainadmin); err != nil {
+			log.Error(err, "unable to update domainadmin password hash")
+			return err
+		}
 	}
 
 	return nil
@@ -220,12 +335,34 @@ func (r *DomainAdminReconciler) ReconcileResource(ctx context.Context, domainadm
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *DomainAdminReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &mailcowv1.DomainAdmin{}, passwordSecretIndex, func(obj client.Object) []string {
+		return []string{obj.(*mailcowv1.DomainAdmin).Spec.PasswordSecret.Name}
+	}); err != nil {
+		return err
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&mailcowv1.DomainAdmin{}).
+		Watches(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(r.findDomainAdminsForSecret)).
 		Named("domainadmin").
 		Complete(r)
 }
 
+// findDomainAdminsForSecret returns a request for every domain admin that uses the secret as password secret.
+func (r *DomainAdminReconciler) findDomainAdminsForSecret(ctx context.Context, secret client.Object) []reconcile.Request {
+	var domainadmins mailcowv1.DomainAdminList
+	if err := r.List(ctx, &domainadmins, client.InNamespace(secret.GetNamespace()), client.MatchingFields{passwordSecretIndex: secret.GetName()}); err != nil {
+		log.FromContext(ctx).Error(err, "unable to list domainadmins for secret", "secret", secret.GetName())
+		return nil
+	}
+
+	requests := make([]reconcile.Request, len(domainadmins.Items))
+	for i, domainadmin := range domainadmins.Items {
+		requests[i] = reconcile.Request{NamespacedName: types.NamespacedName{Name: domainadmin.Name, Namespace: domainadmin.Namespace}}
+	}
+	return requests
+}
+
 func (r *DomainAdminReconciler) setProgressing(ctx context.Context, domainadmin *mailcowv1.DomainAdmin, message string) (bool, error) {
 	changed := helpers.SetConditionStatus(&domainadmin.Status.Conditions, constants.ConditionProgressing, "Reconciling", message, domainadmin.Generation)
 	if !changed {
@@ -244,8 +381,8 @@ func (r *DomainAdminReconciler) setReady(ctx context.Context, domainadmin *mailc
 	return changed, r.Status().Update(ctx, domainadmin)
 }
 
-func (r *DomainAdminReconciler) setDegraded(ctx context.Context, domainadmin *mailcowv1.DomainAdmin, message string) (bool, error) {
-	changed := helpers.SetConditionStatus(&domainadmin.Status.Conditions, constants.ConditionDegraded, "ReconcileFailed", message, domainadmin.Generation)
+func (r *DomainAdminReconciler) setDegraded(ctx context.Context, domainadmin *mailcowv1.DomainAdmin, reason, message string) (bool, error) {
+	changed := helpers.SetConditionStatus(&domainadmin.Status.Conditions, constants.ConditionDegraded, reason, message, domainadmin.Generation)
 	if !changed {
 		return changed, nil
 	}
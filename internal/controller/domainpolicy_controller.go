@@ -0,0 +1,339 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	mailcowv1 "github.com/tarteo/mailcow-operator/api/v1"
+	constants "github.com/tarteo/mailcow-operator/common"
+	helpers "github.com/tarteo/mailcow-operator/helpers"
+	"github.com/tarteo/mailcow-operator/mailcow"
+)
+
+// DomainPolicyReconciler reconciles a DomainPolicy object
+type DomainPolicyReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Clients  *MailcowClients
+	Recorder record.EventRecorder
+}
+
+// +kubebuilder:rbac:groups=mailcow.onestein.nl,resources=domainpolicies,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=mailcow.onestein.nl,resources=domainpolicies/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=mailcow.onestein.nl,resources=domainpolicies/finalizers,verbs=update
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+// Reconcile adds and removes the entries of the allow and deny lists of the domain in mailcow.
+//
+// For more details, check Reconcile and its Result here:
+// - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.19.1/pkg/reconcile
+func (r *DomainPolicyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := log.FromContext(ctx).WithValues("namespace", req.NamespacedName)
+	log.Info("reconciling domainpolicy")
+
+	var domainPolicy mailcowv1.DomainPolicy
+	if err := r.Get(ctx, req.NamespacedName, &domainPolicy); err != nil {
+		if errors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		log.Error(err, "unable to find domainpolicy")
+		return ctrl.Result{}, err
+	}
+
+	// Apply finalizer
+	if domainPolicy.ObjectMeta.DeletionTimestamp.IsZero() {
+		if !controllerutil.ContainsFinalizer(&domainPolicy, constants.Finalizer) {
+			controllerutil.AddFinalizer(&domainPolicy, constants.Finalizer)
+			if err := r.Update(ctx, &domainPolicy); err != nil {
+				log.Error(err, "unable to update domainpolicy with finalizer")
+				return ctrl.Result{}, err
+			}
+
+			// Return and requeue to get fresh object
+			return ctrl.Result{Requeue: true}, nil
+		}
+		// Set progressing status
+		if changed, err := r.setProgressing(ctx, &domainPolicy, "Reconciling domain policy"); err != nil {
+			log.Error(err, "unable to set progressing status")
+			return ctrl.Result{}, err
+		} else if changed {
+			// Requeue to get fresh object with updated status
+			return ctrl.Result{Requeue: true}, nil
+		}
+	}
+
+	// Reconcile the resource
+	if err := r.ReconcileResource(ctx, &domainPolicy); err != nil {
+		log.Error(err, "unable to reconcile mailcow domainpolicy")
+		// Set degraded status
+		changed, errStatus := r.setDegraded(ctx, &domainPolicy, errorReason(err, "ReconcileFailed"), err.Error())
+		if errStatus != nil {
+			log.Error(errStatus, "unable to set degraded status")
+			return ctrl.Result{}, errStatus
+		}
+		// Only record the error when it changed, so requeues don't repeat the same event
+		if changed {
+			recordError(r.Recorder, &domainPolicy, err)
+		}
+		return handleReconcileError(ctx, r.Client, domainPolicy.Namespace, domainPolicy.GetMailcowRef(), err)
+	}
+
+	// Remove finalizer if deletion timestamp is set
+	if !domainPolicy.ObjectMeta.DeletionTimestamp.IsZero() && controllerutil.ContainsFinalizer(&domainPolicy, constants.Finalizer) {
+		controllerutil.RemoveFinalizer(&domainPolicy, constants.Finalizer)
+		if err := r.Update(ctx, &domainPolicy); err != nil {
+			log.Error(err, "unable to update domainpolicy with finalizer")
+			return ctrl.Result{}, err
+		}
+
+		return ctrl.Result{}, nil
+	}
+
+	// Set ready status
+	if _, err := r.setReady(ctx, &domainPolicy, "Domain policy successfully reconciled"); err != nil {
+		log.Error(err, "unable to set ready status")
+		return ctrl.Result{}, err
+	}
+
+	// Requeue to detect drift in mailcow
+	return ctrl.Result{RequeueAfter: resyncInterval(ctx, r, domainPolicy.Namespace, domainPolicy.GetMailcowRef(), domainPolicy.Spec.ResyncInterval)}, nil
+}
+
+func (r *DomainPolicyReconciler) ReconcileResource(ctx context.Context, domainPolicy *mailcowv1.DomainPolicy) error {
+	log := log.FromContext(ctx).WithValues("namespace", types.NamespacedName{Namespace: domainPolicy.Namespace, Name: domainPolicy.Name})
+	var err error
+
+	// A domainpolicy retained by its own deletionPolicy needs no mailcow, so it can be deleted after its mailcow
+	if !domainPolicy.ObjectMeta.DeletionTimestamp.IsZero() && (domainPolicy.Spec.DeletionPolicy == mailcowv1.DeletionPolicyRetain || domainPolicy.Spec.DeletionPolicy == mailcowv1.DeletionPolicyDisable) {
+		log.Info("retaining domainpolicy in mailcow")
+		return nil
+	}
+
+	// Get related mailcow resource
+	res, err := mailcowv1.GetMailcow(ctx, r, domainPolicy.Namespace, domainPolicy.GetMailcowRef())
+	if err != nil {
+		// On deletion, e.g. during a namespace teardown, a mailcow that is gone leaves nothing to clean up
+		if !domainPolicy.ObjectMeta.DeletionTimestamp.IsZero() && errors.IsNotFound(err) {
+			log.Info("leaving domainpolicy untouched, its mailcow no longer exists")
+			return nil
+		}
+		log.Error(err, "unable to find related mailcow resource", "mailcow", domainPolicy.GetMailcowRef().String())
+		return err
+	}
+
+	deleting := !domainPolicy.ObjectMeta.DeletionTimestamp.IsZero()
+	domain := domainPolicy.Spec.Domain
+
+	// Objects in a domain of another namespace are never touched in mailcow, also not on deletion
+	if err := checkDomainOwnership(ctx, r, domainPolicy.Namespace, domainPolicy.GetMailcowRef(), domain); err != nil {
+		if deleting && isDomainNotAllowed(err) {
+			log.Info("leaving domainpolicy in a domain not owned by the namespace untouched in mailcow")
+			return nil
+		}
+		log.Error(err, "unable to use the domain of the domainpolicy")
+		return err
+	}
+
+	// Retain leaves the lists in mailcow untouched on deletion, entries can't be disabled so Disable does the same
+	deletionPolicy := res.GetDeletionPolicy(domainPolicy.Spec.DeletionPolicy)
+	if deleting && deletionPolicy != mailcowv1.DeletionPolicyDelete {
+		log.Info("retaining domainpolicy in mailcow")
+		return nil
+	}
+
+	// Reconcile mailcow domain policy
+	client, err := r.Clients.Get(ctx, r, res)
+	if err != nil {
+		log.Error(err, "unable to create mailcow client")
+		return err
+	}
+
+	lists := []struct {
+		field      string
+		objectList mailcow.CreateDomainPolicyJSONBodyObjectList
+		desired    []string
+		added      []string
+		removed    []string
+	}{
+		{field: "allow", objectList: mailcow.Wl, desired: domainPolicy.Spec.Allow},
+		{field: "deny", objectList: mailcow.Bl, desired: domainPolicy.Spec.Deny},
+	}
+
+	var drifted []string
+	var removed []string
+	for i := range lists {
+		list := &lists[i]
+		live, err := client.ListDomainPolicies(ctx, domain, list.objectList)
+		if err != nil {
+			log.Error(err, "unable to get domainpolicies", "list", list.field)
+			return err
+		}
+
+		if deleting {
+			// Handle deletion, only the entries of the spec are removed
+			_, list.removed = domainPolicyDelta(nil, filterDomainPolicies(live, list.desired))
+		} else {
+			list.added, list.removed = domainPolicyDelta(list.desired, live)
+		}
+		if len(list.added) > 0 || len(list.removed) > 0 {
+			drifted = append(drifted, list.field)
+		}
+		removed = append(removed, list.removed...)
+	}
+
+	if !deleting {
+		if err := recordDrift(ctx, r.Client, r.Recorder, domainPolicy, &domainPolicy.Status.Conditions, drifted); err != nil {
+			log.Error(err, "unable to record drift")
+			return err
+		}
+	}
+
+	// Only the difference is sent to mailcow, entries that are on the list already are left untouched
+	added := 0
+	for _, list := range lists {
+		for _, value := range list.added {
+			_, err = client.CreateDomainPolicyWithResponse(ctx, mailcow.CreateDomainPolicyJSONRequestBody{
+				Domain:     &domain,
+				ObjectFrom: &value,
+				ObjectList: &list.objectList,
+			})
+			if err != nil {
+				log.Error(err, "unable to add domainpolicy", "list", list.field, "value", value)
+				return err
+			}
+			added++
+		}
+	}
+	if len(removed) > 0 {
+		_, err = client.DeleteDomainPolicyWithResponse(ctx, mailcow.DeleteDomainPolicyJSONRequestBody(removed))
+		if err != nil {
+			log.Error(err, "unable to remove domainpolicies")
+			return err
+		}
+	}
+
+	if deleting && len(removed) > 0 {
+		r.Recorder.Eventf(domainPolicy, corev1.EventTypeNormal, "Deleted", "Removed %d entries of the policy of %s from mailcow", len(removed), domain)
+	} else if added > 0 || len(removed) > 0 {
+		r.Recorder.Eventf(domainPolicy, corev1.EventTypeNormal, "Updated", "Added %d and removed %d entries of the policy of %s in mailcow", added, len(removed), domain)
+	}
+
+	return nil
+}
+
+// domainPolicyDelta returns the values of desired that are missing in live, and the prefids of the live entries that are
+// not in desired. Values are compared ignoring case, and duplicate live entries are removed.
+func domainPolicyDelta(desired []string, live []mailcow.DomainPolicy) ([]string, []string) {
+	wanted := make(map[string]bool, len(desired))
+	for _, value := range desired {
+		wanted[normalizePolicyValue(value)] = true
+	}
+
+	seen := make(map[string]bool, len(live))
+	removed := []string{}
+	for _, entry := range live {
+		if entry.Prefid == nil || entry.Value == nil {
+			continue
+		}
+		value := normalizePolicyValue(*entry.Value)
+		if wanted[value] && !seen[value] {
+			seen[value] = true
+			continue
+		}
+		removed = append(removed, strconv.Itoa(*entry.Prefid))
+	}
+
+	added := []string{}
+	for _, value := range desired {
+		value = normalizePolicyValue(value)
+		if value != "" && !seen[value] {
+			seen[value] = true
+			added = append(added, value)
+		}
+	}
+	return added, removed
+}
+
+// filterDomainPolicies returns the live entries whose value is in values.
+func filterDomainPolicies(live []mailcow.DomainPolicy, values []string) []mailcow.DomainPolicy {
+	filtered := []mailcow.DomainPolicy{}
+	for _, entry := range live {
+		if entry.Value == nil {
+			continue
+		}
+		for _, value := range values {
+			if normalizePolicyValue(value) == normalizePolicyValue(*entry.Value) {
+				filtered = append(filtered, entry)
+				break
+			}
+		}
+	}
+	return filtered
+}
+
+// normalizePolicyValue returns the value as mailcow stores it on the allow or deny list.
+func normalizePolicyValue(value string) string {
+	return strings.ToLower(strings.TrimSpace(value))
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *DomainPolicyReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&mailcowv1.DomainPolicy{}).
+		Named("domainpolicy").
+		Complete(r)
+}
+
+func (r *DomainPolicyReconciler) setProgressing(ctx context.Context, domainPolicy *mailcowv1.DomainPolicy, message string) (bool, error) {
+	changed := helpers.SetConditionStatus(&domainPolicy.Status.Conditions, constants.ConditionProgressing, "Reconciling", message, domainPolicy.Generation)
+	if !changed {
+		return changed, nil
+	}
+	domainPolicy.Status.Phase = constants.ConditionProgressing
+	return changed, r.Status().Update(ctx, domainPolicy)
+}
+
+func (r *DomainPolicyReconciler) setReady(ctx context.Context, domainPolicy *mailcowv1.DomainPolicy, message string) (bool, error) {
+	changed := helpers.SetConditionStatus(&domainPolicy.Status.Conditions, constants.ConditionReady, "Reconciled", message, domainPolicy.Generation)
+	if !changed {
+		return changed, nil
+	}
+	domainPolicy.Status.Phase = constants.ConditionReady
+	return changed, r.Status().Update(ctx, domainPolicy)
+}
+
+func (r *DomainPolicyReconciler) setDegraded(ctx context.Context, domainPolicy *mailcowv1.DomainPolicy, reason, message string) (bool, error) {
+	changed := helpers.SetConditionStatus(&domainPolicy.Status.Conditions, constants.ConditionDegraded, reason, message, domainPolicy.Generation)
+	if !changed {
+		return changed, nil
+	}
+	domainPolicy.Status.Phase = constants.ConditionDegraded
+	return changed, r.Status().Update(ctx, domainPolicy)
+}
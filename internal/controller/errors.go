@@ -0,0 +1,170 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	mailcowv1 "github.com/tarteo/mailcow-operator/api/v1"
+	constants "github.com/tarteo/mailcow-operator/common"
+	helpers "github.com/tarteo/mailcow-operator/helpers"
+	"github.com/tarteo/mailcow-operator/mailcow"
+)
+
+const (
+	// permanentErrorRetryInterval is how long to wait before retrying a request mailcow rejected,
+	// a spec change triggers a reconcile before that.
+	permanentErrorRetryInterval = 10 * time.Minute
+	// rateLimitedRetryInterval is how long to wait before retrying a throttled request without a Retry-After.
+	rateLimitedRetryInterval = 30 * time.Second
+)
+
+// errorReason returns the condition reason for an error, each type of mailcow error has its own reason.
+func errorReason(err error, fallback string) string {
+	if reason := mailcow.ReasonForError(err); reason != "" {
+		return string(reason)
+	}
+	if isDomainNotAllowed(err) {
+		return "DomainNotAllowed"
+	}
+	if isDomainClaimed(err) {
+		return "DomainClaimed"
+	}
+	if isNotAdopted(err) {
+		return "NotAdopted"
+	}
+	if isMailcowMismatch(err) {
+		return "MailcowMismatch"
+	}
+	return fallback
+}
+
+// handleReconcileError decides how a failed reconcile is retried, based on the type of mailcow error.
+// Rejected requests are retried slowly instead of with the exponential backoff, server and transport errors use the
+// backoff. A rejected API key is also reported on the Mailcow or ClusterMailcow, so it shows up in one place.
+func handleReconcileError(ctx context.Context, c client.Client, namespace string, ref mailcowv1.MailcowReference, err error) (ctrl.Result, error) {
+	// Only a change of the Domain or the resource can fix the ownership, so it is retried slowly
+	if isDomainNotAllowed(err) || isDomainClaimed(err) || isNotAdopted(err) || isMailcowMismatch(err) {
+		return ctrl.Result{RequeueAfter: permanentErrorRetryInterval}, nil
+	}
+	switch mailcow.ReasonForError(err) {
+	case mailcow.ErrorReasonValidationFailed:
+		return ctrl.Result{RequeueAfter: permanentErrorRetryInterval}, nil
+	case mailcow.ErrorReasonUnauthorized:
+		if ref.Name != "" {
+			if errStatus := reportUnauthorized(ctx, c, namespace, ref, err); errStatus != nil {
+				log.FromContext(ctx).Error(errStatus, "unable to report unauthorized on mailcow", "mailcow", ref.String())
+			}
+		}
+		return ctrl.Result{RequeueAfter: permanentErrorRetryInterval}, nil
+	case mailcow.ErrorReasonRateLimited:
+		retryAfter := mailcow.RetryAfterForError(err)
+		if retryAfter <= 0 {
+			retryAfter = rateLimitedRetryInterval
+		}
+		return ctrl.Result{RequeueAfter: retryAfter}, nil
+	}
+	return ctrl.Result{}, err
+}
+
+// reportUnauthorized sets the Mailcow Degraded when mailcow rejected its API key.
+func reportUnauthorized(ctx context.Context, c client.Client, namespace string, ref mailcowv1.MailcowReference, err error) error {
+	res, errGet := mailcowv1.GetMailcow(ctx, c, namespace, ref)
+	if errGet != nil {
+		return client.IgnoreNotFound(errGet)
+	}
+	status := res.GetMailcowStatus()
+	if !helpers.SetConditionStatus(&status.Conditions, constants.ConditionDegraded, string(mailcow.ErrorReasonUnauthorized), err.Error(), res.GetGeneration()) {
+		return nil
+	}
+	status.Phase = constants.ConditionDegraded
+	return c.Status().Update(ctx, res)
+}
+
+// mailboxMailcow returns the reference to the Mailcow of a mailbox, or an empty reference when the mailbox doesn't exist.
+func mailboxMailcow(ctx context.Context, c client.Reader, namespace, mailbox string) mailcowv1.MailcowReference {
+	var res mailcowv1.Mailbox
+	if err := c.Get(ctx, types.NamespacedName{Name: mailbox, Namespace: namespace}, &res); err != nil {
+		return mailcowv1.MailcowReference{}
+	}
+	return res.GetMailcowRef()
+}
+
+// checkDomainOwnership checks that the domains used by a resource are owned by or shared with its namespace.
+func checkDomainOwnership(ctx context.Context, c client.Reader, namespace string, ref mailcowv1.MailcowReference, domains ...string) error {
+	for _, domain := range domains {
+		if err := mailcowv1.CheckDomainOwnership(ctx, c, namespace, ref, domain); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isDomainNotAllowed returns whether the error is caused by a domain the namespace doesn't own.
+func isDomainNotAllowed(err error) bool {
+	var notAllowed *mailcowv1.DomainNotAllowedError
+	return errors.As(err, &notAllowed)
+}
+
+// isDomainClaimed returns whether the error is caused by a domain an older Domain already manages.
+func isDomainClaimed(err error) bool {
+	var claimed *mailcowv1.DomainClaimedError
+	return errors.As(err, &claimed)
+}
+
+// notAdoptedError is returned when an object the resource didn't create already exists in mailcow, and the resource
+// doesn't adopt it.
+type notAdoptedError struct {
+	// Object describes the object in mailcow, e.g. "transport map of example.com".
+	Object string
+}
+
+func (e *notAdoptedError) Error() string {
+	return fmt.Sprintf("%s already exists in mailcow, annotate the resource with %s: \"true\" to adopt it", e.Object, constants.AnnotationAdopt)
+}
+
+// isNotAdopted returns whether the error is caused by an existing object in mailcow the resource doesn't adopt.
+func isNotAdopted(err error) bool {
+	var notAdopted *notAdoptedError
+	return errors.As(err, &notAdopted)
+}
+
+// mailcowMismatchError is returned when a resource references a resource of another mailcow.
+type mailcowMismatchError struct {
+	// Kind and Name identify the referenced resource.
+	Kind    string
+	Name    string
+	Mailcow mailcowv1.MailcowReference
+}
+
+func (e *mailcowMismatchError) Error() string {
+	return fmt.Sprintf("%s `%s` uses %s, not the mailcow of the resource", e.Kind, e.Name, e.Mailcow.String())
+}
+
+// isMailcowMismatch returns whether the error is caused by a reference to a resource of another mailcow.
+func isMailcowMismatch(err error) bool {
+	var mismatch *mailcowMismatchError
+	return errors.As(err, &mismatch)
+}
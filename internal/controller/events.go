@@ -0,0 +1,38 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"errors"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/tarteo/mailcow-operator/mailcow"
+)
+
+// recordError records a Warning event for a failed reconcile, with the message mailcow returned when there is one.
+// It is only called when the Degraded condition changed, so the same error is not recorded again on every requeue.
+func recordError(recorder record.EventRecorder, obj runtime.Object, err error) {
+	message := err.Error()
+	var mailcowErr *mailcow.Error
+	if errors.As(err, &mailcowErr) && mailcowErr.Message != "" {
+		message = mailcowErr.Message
+	}
+	recorder.Event(obj, corev1.EventTypeWarning, errorReason(err, "ReconcileFailed"), message)
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	mailcowv1 "github.com/tarteo/mailcow-operator/api/v1"
+	helpers "github.com/tarteo/mailcow-operator/helpers"
+)
+
+// ensureOwnerReference makes owner an owner of obj, so obj is garbage collected when owner is deleted.
+// It is not a controller reference, the resource keeps being reconciled by its own controller.
+func ensureOwnerReference(ctx context.Context, c client.Client, scheme *runtime.Scheme, owner, obj client.Object) error {
+	for _, ref := range obj.GetOwnerReferences() {
+		if ref.UID == owner.GetUID() {
+			return nil
+		}
+	}
+	if err := controllerutil.SetOwnerReference(owner, obj, scheme); err != nil {
+		return err
+	}
+	return c.Update(ctx, obj)
+}
+
+// localDestDomain returns the domain of a local destination, which is a domain, an email address or a catch-all.
+func localDestDomain(localDest string) string {
+	if strings.Contains(localDest, "@") {
+		return helpers.EmailDomain(localDest)
+	}
+	return localDest
+}
+
+// localDestPhase returns the phase of the Domain or Mailbox a local destination is resolved from.
+func localDestPhase(obj client.Object) string {
+	switch obj := obj.(type) {
+	case *mailcowv1.Domain:
+		return obj.Status.Phase
+	case *mailcowv1.Mailbox:
+		return obj.Status.Phase
+	}
+	return ""
+}
@@ -18,14 +18,23 @@ package controller
 
 import (
 	"context"
+	"fmt"
+	"time"
 
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	mailcowv1 "github.com/tarteo/mailcow-operator/api/v1"
 	constants "github.com/tarteo/mailcow-operator/common"
@@ -33,16 +42,22 @@ import (
 	"github.com/tarteo/mailcow-operator/mailcow"
 )
 
+// passwordSecretIndex indexes resources by the name of their password secret, to find them when the secret changes.
+const passwordSecretIndex = ".spec.passwordSecret.name"
+
 // MailboxReconciler reconciles a Mailbox object
 type MailboxReconciler struct {
 	client.Client
-	Scheme *runtime.Scheme
+	Scheme   *runtime.Scheme
+	Clients  *MailcowClients
+	Recorder record.EventRecorder
 }
 
 // +kubebuilder:rbac:groups=mailcow.onestein.nl,resources=mailboxes,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=mailcow.onestein.nl,resources=mailboxes/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=mailcow.onestein.nl,resources=mailboxes/finalizers,verbs=update
-// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;update;patch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -91,16 +106,22 @@ func (r *MailboxReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 	if err := r.ReconcileResource(ctx, &mailbox); err != nil {
 		log.Error(err, "unable to reconcile mailcow mailbox")
 		// Set degraded status
-		if _, errStatus := r.setDegraded(ctx, &mailbox, err.Error()); errStatus != nil {
+		changed, errStatus := r.setDegraded(ctx, &mailbox, errorReason(err, "ReconcileFailed"), err.Error())
+		if errStatus != nil {
 			log.Error(errStatus, "unable to set degraded status")
 			return ctrl.Result{}, errStatus
 		}
-		return ctrl.Result{}, err
+		// Only record the error when it changed, so requeues don't repeat the same event
+		if changed {
+			recordError(r.Recorder, &mailbox, err)
+		}
+		return handleReconcileError(ctx, r.Client, mailbox.Namespace, mailbox.GetMailcowRef(), err)
 	}
 
 	// Remove finalizer if deletion timestamp is set
 	if !mailbox.ObjectMeta.DeletionTimestamp.IsZero() && controllerutil.ContainsFinalizer(&mailbox, constants.Finalizer) {
 		controllerutil.RemoveFinalizer(&mailbox, constants.Finalizer)
+		forgetMailboxMetrics(req.NamespacedName)
 		if err := r.Update(ctx, &mailbox); err != nil {
 			log.Error(err, "unable to update mailbox with finalizer")
 			return ctrl.Result{}, err
@@ -115,22 +136,55 @@ func (r *MailboxReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 		return ctrl.Result{}, err
 	}
 
-	return ctrl.Result{}, nil
+	// Requeue to detect drift in mailcow and to refresh the usage
+	interval := resyncInterval(ctx, r, mailbox.Namespace, mailbox.GetMailcowRef(), mailbox.Spec.ResyncInterval)
+	if refresh := mailbox.GetUsageRefreshInterval(); refresh != nil && refresh.Duration > 0 && (interval == 0 || refresh.Duration < interval) {
+		interval = refresh.Duration
+	}
+	return ctrl.Result{RequeueAfter: interval}, nil
 }
 
 func (r *MailboxReconciler) ReconcileResource(ctx context.Context, mailbox *mailcowv1.Mailbox) error {
 	log := log.FromContext(ctx).WithValues("namespace", types.NamespacedName{Namespace: mailbox.Namespace, Name: mailbox.Name})
 	var err error
 
+	// A mailbox retained by its own deletionPolicy needs no mailcow, so it can be deleted after its mailcow
+	if !mailbox.ObjectMeta.DeletionTimestamp.IsZero() && mailbox.Spec.DeletionPolicy == mailcowv1.DeletionPolicyRetain {
+		log.Info("retaining mailbox in mailcow")
+		return releasePasswordSecret(ctx, r.Client, r.Recorder, mailbox, mailbox.Spec.PasswordSecret)
+	}
+
 	// Get related mailcow resource
-	var res mailcowv1.Mailcow
-	if err := r.Get(ctx, types.NamespacedName{Name: mailbox.Spec.Mailcow, Namespace: mailbox.Namespace}, &res); err != nil {
-		log.Error(err, "unable to find related mailcow resource", "mailcow", mailbox.Spec.Mailcow)
+	res, err := mailcowv1.GetMailcow(ctx, r, mailbox.Namespace, mailbox.GetMailcowRef())
+	if err != nil {
+		// On deletion, e.g. during a namespace teardown, a mailcow that is gone leaves nothing to clean up
+		if !mailbox.ObjectMeta.DeletionTimestamp.IsZero() && errors.IsNotFound(err) {
+			log.Info("leaving mailbox untouched, its mailcow no longer exists")
+			return releasePasswordSecret(ctx, r.Client, r.Recorder, mailbox, mailbox.Spec.PasswordSecret)
+		}
+		log.Error(err, "unable to find related mailcow resource", "mailcow", mailbox.GetMailcowRef().String())
 		return err
 	}
 
+	// Objects in a domain of another namespace are never touched in mailcow, also not on deletion
+	if err := checkDomainOwnership(ctx, r, mailbox.Namespace, mailbox.GetMailcowRef(), mailbox.Spec.Domain); err != nil {
+		if !mailbox.ObjectMeta.DeletionTimestamp.IsZero() && isDomainNotAllowed(err) {
+			log.Info("leaving mailbox in a domain not owned by the namespace untouched in mailcow")
+			return releasePasswordSecret(ctx, r.Client, r.Recorder, mailbox, mailbox.Spec.PasswordSecret)
+		}
+		log.Error(err, "unable to use the domain of the mailbox")
+		return err
+	}
+
+	// Retain leaves the mailbox in mailcow untouched on deletion
+	deletionPolicy := res.GetDeletionPolicy(mailbox.Spec.DeletionPolicy)
+	if !mailbox.ObjectMeta.DeletionTimestamp.IsZero() && deletionPolicy == mailcowv1.DeletionPolicyRetain {
+		log.Info("retaining mailbox in mailcow")
+		return releasePasswordSecret(ctx, r.Client, r.Recorder, mailbox, mailbox.Spec.PasswordSecret)
+	}
+
 	// Create mailcow client
-	client, err := res.GetClient(ctx, r)
+	client, err := r.Clients.Get(ctx, r, res)
 	if err != nil {
 		log.Error(err, "unable to create mailcow client")
 		return err
@@ -147,24 +201,57 @@ func (r *MailboxReconciler) ReconcileResource(ctx context.Context, mailbox *mail
 
 	if !mailbox.ObjectMeta.DeletionTimestamp.IsZero() {
 		// Handle deletion
-		if response.JSON200.Username != nil {
+		if response.JSON200.Username != nil && deletionPolicy == mailcowv1.DeletionPolicyDisable {
+			active := false
+			_, err = client.UpdateMailboxWithResponse(ctx, mailcow.UpdateMailboxJSONRequestBody{
+				Attr:  &mailcow.EditMailboxAttr{Active: &active},
+				Items: &[]string{email},
+			})
+			if err != nil {
+				log.Error(err, "unable to disable mailbox")
+				return err
+			}
+			r.Recorder.Eventf(mailbox, corev1.EventTypeNormal, "Disabled", "Disabled mailbox %s in mailcow", email)
+		} else if response.JSON200.Username != nil {
 			_, err = client.DeleteMailboxWithResponse(ctx, mailcow.DeleteMailboxJSONRequestBody{email})
 			if err != nil {
 				log.Error(err, "unable to delete mailbox")
 				return err
 			}
+			r.Recorder.Eventf(mailbox, corev1.EventTypeNormal, "Deleted", "Deleted mailbox %s from mailcow", email)
+		}
+		// The generated password of a disabled mailbox is kept
+		if deletionPolicy == mailcowv1.DeletionPolicyDisable {
+			return releasePasswordSecret(ctx, r.Client, r.Recorder, mailbox, mailbox.Spec.PasswordSecret)
 		}
 		return nil
 	}
 
-	// Get password from secret
-	password, err := mailbox.GetPassword(ctx, r)
+	// Generate the password secret when it doesn't exist, otherwise get the password from the secret
+	password, err := ensurePasswordSecret(ctx, r.Client, r.Recorder, mailbox, "Mailbox", mailbox.Spec.PasswordSecret, mailbox.Spec.GeneratePassword)
+	if err != nil {
+		log.Error(err, "unable to generate password secret")
+		return err
+	}
+	if password == "" {
+		password, err = mailbox.GetPassword(ctx, r)
+	}
 	if err != nil {
 		log.Error(err, "unable to get password from secret")
 		return err
 	}
 
+	// Salt with the uid, so the hash in the status can't be compared across resources
+	passwordHash := helpers.Hash(string(mailbox.UID), password)
+
+	adopted := mailbox.Annotations[constants.AnnotationAdopt] == "true"
+
 	if response.JSON200.Username == nil {
+		// Adopted mailboxes are never recreated
+		if adopted {
+			return fmt.Errorf("adopted mailbox %s does not exist in mailcow", email)
+		}
+
 		// Mailbox does not exist, create it
 		_, err = client.CreateMailboxWithResponse(ctx, mailcow.CreateMailboxJSONRequestBody{
 			Domain:        &mailbox.Spec.Domain,
@@ -181,19 +268,59 @@ func (r *MailboxReconciler) ReconcileResource(ctx context.Context, mailbox *mail
 			log.Error(err, "unable to create mailbox")
 			return err
 		}
+		r.Recorder.Eventf(mailbox, corev1.EventTypeNormal, "Created", "Created mailbox %s in mailcow", email)
 	} else {
-		// Mailbox exists, update it
+		// Mailbox exists, compare it against the spec
+		live := response.JSON200
+		setMailboxQuotaMetrics(types.NamespacedName{Namespace: mailbox.Namespace, Name: mailbox.Name}, email, live)
+		var drifted []string
+		if helpers.BoolDrifted(mailbox.Spec.Active, live.Active) {
+			drifted = append(drifted, "active")
+		}
+		if helpers.QuotaDrifted(mailbox.Spec.Quota, live.Quota) {
+			drifted = append(drifted, "quota")
+		}
+		if live.Attributes != nil && helpers.BoolStringDrifted(mailbox.Spec.SogoAccess, live.Attributes.SogoAccess) {
+			drifted = append(drifted, "sogoAccess")
+		}
+
+		if err := recordDrift(ctx, r.Client, r.Recorder, mailbox, &mailbox.Status.Conditions, drifted); err != nil {
+			log.Error(err, "unable to record drift")
+			return err
+		}
+
+		// Report the usage, the status is only written when it changed
+		if r.setUsage(mailbox, live) {
+			if err := r.Status().Update(ctx, mailbox); err != nil {
+				log.Error(err, "unable to update mailbox usage")
+				return err
+			}
+		}
+
+		// Fields mailcow doesn't return, like the sender ACL, are only pushed when the spec changed
+		// The password of an adopted mailbox is unknown, it is only pushed once the secret is rotated
+		passwordChanged := mailbox.Status.PasswordHash != passwordHash && !(adopted && mailbox.Status.PasswordHash == "")
+		if len(drifted) == 0 && !passwordChanged && helpers.IsReconciled(mailbox.Status.Conditions, mailbox.Generation) {
+			return nil
+		}
+
+		// Mailbox drifted or the spec changed, update it
+		attr := mailcow.EditMailboxAttr{
+			// Name: &mailbox.Spec.Name,
+			Active: mailbox.Spec.Active,
+			// ForcePwUpdate: mailbox.Spec.ForcePasswordChange,
+			Quota:      helpers.Int64ToFloat32(mailbox.Spec.Quota),
+			SogoAccess: mailbox.Spec.SogoAccess,
+			SenderAcl:  mailbox.Spec.SenderACL,
+		}
+		// Only push the password when the secret changed, so a password is not reset on every reconcile
+		if passwordChanged {
+			log.Info("password changed, updating mailbox password")
+			attr.Password = &password
+			attr.Password2 = &password
+		}
 		_, err = client.UpdateMailboxWithResponse(ctx, mailcow.UpdateMailboxJSONRequestBody{
-			Attr: &mailcow.EditMailboxAttr{
-				// Name: &mailbox.Spec.Name,
-				// Password:      &password, // Should we always update the password? assuming someone might want to change it
-				// Password2:     &password,
-				Active: mailbox.Spec.Active,
-				// ForcePwUpdate: mailbox.Spec.ForcePasswordChange,
-				Quota:      helpers.Int64ToFloat32(mailbox.Spec.Quota),
-				SogoAccess: mailbox.Spec.SogoAccess,
-				SenderAcl:  mailbox.Spec.SenderACL,
-			},
+			Attr:  &attr,
 			Items: &[]string{email},
 		})
 
@@ -201,19 +328,100 @@ func (r *MailboxReconciler) ReconcileResource(ctx context.Context, mailbox *mail
 			log.Error(err, "unable to update mailbox")
 			return err
 		}
+		r.Recorder.Eventf(mailbox, corev1.EventTypeNormal, "Updated", "Updated mailbox %s in mailcow", email)
+	}
+
+	if mailbox.Status.PasswordHash != passwordHash {
+		mailbox.Status.PasswordHash = passwordHash
+		if err := r.Status().Update(ctx, mailbox); err != nil {
+			log.Error(err, "unable to update mailbox password hash")
+			return err
+		}
 	}
 
 	return nil
 }
 
+// setUsage fills the status with the quota usage and activity mailcow returned for the mailbox, and sets the
+// QuotaNearlyFull condition from the threshold. It returns whether the status changed.
+func (r *MailboxReconciler) setUsage(mailbox *mailcowv1.Mailbox, live *mailcow.Mailbox) bool {
+	before := mailbox.Status.DeepCopy()
+	status := &mailbox.Status
+
+	status.Quota = helpers.IntToInt64(live.Quota)
+	status.QuotaUsed = helpers.IntToInt64(live.QuotaUsed)
+	status.Messages = helpers.IntToInt64(live.Messages)
+	status.LastIMAPLogin = loginTime(live.LastImapLogin)
+	status.LastPOP3Login = loginTime(live.LastPop3Login)
+	status.LastSMTPLogin = loginTime(live.LastSmtpLogin)
+
+	status.QuotaPercent = nil
+	if status.Quota != nil && status.QuotaUsed != nil && *status.Quota > 0 {
+		percent := int32(*status.QuotaUsed * 100 / *status.Quota)
+		status.QuotaPercent = &percent
+	}
+
+	threshold := mailbox.GetQuotaNearlyFullThreshold()
+	condition := metav1.Condition{
+		Type:               constants.ConditionQuotaNearlyFull,
+		Status:             metav1.ConditionFalse,
+		Reason:             "QuotaAvailable",
+		Message:            fmt.Sprintf("Less than %d%% of the quota is in use", threshold),
+		ObservedGeneration: mailbox.Generation,
+	}
+	if status.QuotaPercent != nil && *status.QuotaPercent >= threshold {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = "QuotaNearlyFull"
+		condition.Message = fmt.Sprintf("%d%% of the quota is in use", *status.QuotaPercent)
+	}
+	// Only warn once when the mailbox becomes nearly full
+	if condition.Status == metav1.ConditionTrue && !meta.IsStatusConditionTrue(status.Conditions, constants.ConditionQuotaNearlyFull) {
+		r.Recorder.Event(mailbox, corev1.EventTypeWarning, "QuotaNearlyFull", condition.Message)
+	}
+	meta.SetStatusCondition(&status.Conditions, condition)
+
+	return !equality.Semantic.DeepEqual(before, status)
+}
+
+// loginTime converts a login timestamp returned by mailcow, 0 means the mailbox never logged in.
+func loginTime(timestamp *int) *metav1.Time {
+	if timestamp == nil || *timestamp == 0 {
+		return nil
+	}
+	t := metav1.NewTime(time.Unix(int64(*timestamp), 0))
+	return &t
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *MailboxReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &mailcowv1.Mailbox{}, passwordSecretIndex, func(obj client.Object) []string {
+		return []string{obj.(*mailcowv1.Mailbox).Spec.PasswordSecret.Name}
+	}); err != nil {
+		return err
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&mailcowv1.Mailbox{}).
+		Watches(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(r.findMailboxesForSecret)).
 		Named("mailbox").
 		Complete(r)
 }
 
+// findMailboxesForSecret returns a request for every mailbox that uses the secret as password secret.
+func (r *MailboxReconciler) findMailboxesForSecret(ctx context.Context, secret client.Object) []reconcile.Request {
+	var mailboxes mailcowv1.MailboxList
+	if err := r.List(ctx, &mailboxes, client.InNamespace(secret.GetNamespace()), client.MatchingFields{passwordSecretIndex: secret.GetName()}); err != nil {
+		log.FromContext(ctx).Error(err, "unable to list mailboxes for secret", "secret", secret.GetName())
+		return nil
+	}
+
+	requests := make([]reconcile.Request, len(mailboxes.Items))
+	for i, mailbox := range mailboxes.Items {
+		requests[i] = reconcile.Request{NamespacedName: types.NamespacedName{Name: mailbox.Name, Namespace: mailbox.Namespace}}
+	}
+	return requests
+}
+
 func (r *MailboxReconciler) setProgressing(ctx context.Context, mailbox *mailcowv1.Mailbox, message string) (bool, error) {
 	changed := helpers.SetConditionStatus(&mailbox.Status.Conditions, constants.ConditionProgressing, "Reconciling", message, mailbox.Generation)
 	if !changed {
@@ -232,8 +440,8 @@ func (r *MailboxReconciler) setReady(ctx context.Context, mailbox *mailcowv1.Mai
 	return changed, r.Status().Update(ctx, mailbox)
 }
 
-func (r *MailboxReconciler) setDegraded(ctx context.Context, mailbox *mailcowv1.Mailbox, message string) (bool, error) {
-	changed := helpers.SetConditionStatus(&mailbox.Status.Conditions, constants.ConditionDegraded, "ReconcileFailed", message, mailbox.Generation)
+func (r *MailboxReconciler) setDegraded(ctx context.Context, mailbox *mailcowv1.Mailbox, reason, message string) (bool, error) {
+	changed := helpers.SetConditionStatus(&mailbox.Status.Conditions, constants.ConditionDegraded, reason, message, mailbox.Generation)
 	if !changed {
 		return changed, nil
 	}
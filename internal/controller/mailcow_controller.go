@@ -0,0 +1,290 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	mailcowv1 "github.com/tarteo/mailcow-operator/api/v1"
+	constants "github.com/tarteo/mailcow-operator/common"
+	helpers "github.com/tarteo/mailcow-operator/helpers"
+)
+
+const defaultHealthCheckInterval = 5 * time.Minute
+
+// MailcowReconciler reconciles a Mailcow object
+type MailcowReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Clients  *MailcowClients
+	Recorder record.EventRecorder
+}
+
+// +kubebuilder:rbac:groups=mailcow.onestein.nl,resources=mailcows,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=mailcow.onestein.nl,resources=mailcows/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=mailcow.onestein.nl,resources=mailcows/finalizers,verbs=update
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+// Reconcile checks the health of the mailcow instance on a schedule and reports it in the status.
+//
+// For more details, check Reconcile and its Result here:
+// - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.19.1/pkg/reconcile
+func (r *MailcowReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := log.FromContext(ctx).WithValues("namespace", req.NamespacedName)
+	log.Info("reconciling mailcow")
+
+	var res mailcowv1.Mailcow
+	if err := r.Get(ctx, req.NamespacedName, &res); err != nil {
+		if errors.IsNotFound(err) {
+			// Drop the shared client and the metrics of the deleted mailcow
+			r.Clients.Forget(req.NamespacedName)
+			forgetMailcowMetrics(req.NamespacedName)
+			return ctrl.Result{}, nil
+		}
+		log.Error(err, "unable to find mailcow")
+		return ctrl.Result{}, err
+	}
+
+	interval := defaultHealthCheckInterval
+	if res.Spec.HealthCheckInterval != nil && res.Spec.HealthCheckInterval.Duration > 0 {
+		interval = res.Spec.HealthCheckInterval.Duration
+	}
+
+	// Set progressing status
+	if changed, err := r.setProgressing(ctx, &res, "Checking mailcow health"); err != nil {
+		log.Error(err, "unable to set progressing status")
+		return ctrl.Result{}, err
+	} else if changed {
+		// Requeue to get fresh object with updated status
+		return ctrl.Result{Requeue: true}, nil
+	}
+
+	reason, err := r.ReconcileResource(ctx, &res)
+
+	// Always record the observed health, also when the check failed
+	now := metav1.Now()
+	res.Status.LastHealthCheck = &now
+	if err := r.Status().Update(ctx, &res); err != nil {
+		log.Error(err, "unable to update mailcow status")
+		return ctrl.Result{}, err
+	}
+
+	if err != nil {
+		log.Error(err, "mailcow is unhealthy")
+		changed, errStatus := r.setDegraded(ctx, &res, reason, err.Error())
+		if errStatus != nil {
+			log.Error(errStatus, "unable to set degraded status")
+			return ctrl.Result{}, errStatus
+		}
+		// Only record the problem when it changed, so every health check doesn't repeat the same event
+		if changed {
+			r.Recorder.Event(&res, corev1.EventTypeWarning, reason, err.Error())
+		}
+		// An unhealthy mailcow is an observed state, not a reconcile error, check again on the next interval
+		return ctrl.Result{RequeueAfter: interval}, nil
+	}
+
+	// Set ready status
+	if _, err := r.setReady(ctx, &res, fmt.Sprintf("Mailcow %s is healthy", res.Status.Version)); err != nil {
+		log.Error(err, "unable to set ready status")
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{RequeueAfter: interval}, nil
+}
+
+// ReconcileResource fills the status with the health of the mailcow instance.
+// When the instance is unhealthy it returns the reason and an error describing the problem.
+func (r *MailcowReconciler) ReconcileResource(ctx context.Context, res *mailcowv1.Mailcow) (string, error) {
+	return checkHealth(ctx, r, r.Clients, res)
+}
+
+// checkHealth fills the status of a Mailcow or ClusterMailcow with the health of the mailcow instance.
+func checkHealth(ctx context.Context, r client.Reader, clients *MailcowClients, res mailcowv1.MailcowInstance) (string, error) {
+	log := log.FromContext(ctx).WithValues("namespace", types.NamespacedName{Namespace: res.GetNamespace(), Name: res.GetName()})
+	status := res.GetMailcowStatus()
+
+	// Create mailcow client
+	client, err := clients.Get(ctx, r, res)
+	if err != nil {
+		log.Error(err, "unable to create mailcow client")
+		return errorReason(err, "ClientFailed"), err
+	}
+
+	// Version, also verifies the endpoint is reachable and the API key is valid
+	versionResponse, err := client.GetVersionStatusWithResponse(ctx)
+	if err != nil {
+		return errorReason(err, "Unreachable"), err
+	}
+	if versionResponse.StatusCode() != http.StatusOK {
+		return "UnexpectedResponse", fmt.Errorf("unexpected response from mailcow: %s", versionResponse.Status())
+	}
+	if versionResponse.JSON200 != nil && versionResponse.JSON200.Version != nil {
+		status.Version = *versionResponse.JSON200.Version
+	}
+
+	// Containers
+	containerResponse, err := client.GetContainerStatusWithResponse(ctx)
+	if err != nil {
+		return errorReason(err, "Unreachable"), err
+	}
+	status.Containers = nil
+	var notRunning []string
+	if containerResponse.JSON200 != nil {
+		for name, container := range *containerResponse.JSON200 {
+			containerStatus := mailcowv1.MailcowContainerStatus{Name: name}
+			if container.State != nil {
+				containerStatus.State = *container.State
+			}
+			if container.Image != nil {
+				containerStatus.Image = *container.Image
+			}
+			if container.StartedAt != nil {
+				containerStatus.StartedAt = *container.StartedAt
+			}
+			if containerStatus.State != "running" {
+				notRunning = append(notRunning, name)
+			}
+			status.Containers = append(status.Containers, containerStatus)
+		}
+	}
+	sort.Slice(status.Containers, func(i, j int) bool {
+		return status.Containers[i].Name < status.Containers[j].Name
+	})
+	sort.Strings(notRunning)
+
+	// Vmail
+	vmailResponse, err := client.GetVmailStatusWithResponse(ctx)
+	if err != nil {
+		return errorReason(err, "Unreachable"), err
+	}
+	if vmail := vmailResponse.JSON200; vmail != nil {
+		status.Vmail = &mailcowv1.MailcowVmailStatus{}
+		if vmail.Disk != nil {
+			status.Vmail.Disk = *vmail.Disk
+		}
+		if vmail.Total != nil {
+			status.Vmail.Total = *vmail.Total
+		}
+		if vmail.Used != nil {
+			status.Vmail.Used = *vmail.Used
+		}
+		if vmail.UsedPercent != nil {
+			status.Vmail.UsedPercent = *vmail.UsedPercent
+		}
+	}
+
+	// Solr
+	solrResponse, err := client.GetSolrStatusWithResponse(ctx)
+	if err != nil {
+		return errorReason(err, "Unreachable"), err
+	}
+	if solr := solrResponse.JSON200; solr != nil {
+		status.Solr = &mailcowv1.MailcowSolrStatus{}
+		if solr.SolrEnabled != nil {
+			status.Solr.Enabled = *solr.SolrEnabled
+		}
+		if solr.SolrDocuments != nil {
+			status.Solr.Documents = *solr.SolrDocuments
+		}
+		if solr.SolrSize != nil {
+			status.Solr.Size = *solr.SolrSize
+		}
+	}
+
+	// Queue and quarantine, only exported as metrics
+	name := types.NamespacedName{Namespace: res.GetNamespace(), Name: res.GetName()}
+	queue, err := client.ListQueue(ctx)
+	if err != nil {
+		return errorReason(err, "Unreachable"), err
+	}
+	queues := make(map[string]int)
+	for _, item := range queue {
+		queueName := "unknown"
+		if item.QueueName != nil {
+			queueName = *item.QueueName
+		}
+		queues[queueName]++
+	}
+	setQueueMessages(name, queues)
+
+	quarantine, err := client.ListQuarantine(ctx)
+	if err != nil {
+		return errorReason(err, "Unreachable"), err
+	}
+	quarantineMessages.WithLabelValues(name.Namespace, name.Name).Set(float64(len(quarantine)))
+
+	if len(notRunning) > 0 {
+		return "ContainersNotRunning", fmt.Errorf("containers not running: %s", strings.Join(notRunning, ", "))
+	}
+
+	return "", nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *MailcowReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		// Only react to spec changes, the status is updated on every health check
+		For(&mailcowv1.Mailcow{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Named("mailcow").
+		Complete(r)
+}
+
+func (r *MailcowReconciler) setProgressing(ctx context.Context, res *mailcowv1.Mailcow, message string) (bool, error) {
+	changed := helpers.SetConditionStatus(&res.Status.Conditions, constants.ConditionProgressing, "Reconciling", message, res.Generation)
+	if !changed {
+		return changed, nil
+	}
+	res.Status.Phase = constants.ConditionProgressing
+	return changed, r.Status().Update(ctx, res)
+}
+
+func (r *MailcowReconciler) setReady(ctx context.Context, res *mailcowv1.Mailcow, message string) (bool, error) {
+	changed := helpers.SetConditionStatus(&res.Status.Conditions, constants.ConditionReady, "Healthy", message, res.Generation)
+	if !changed {
+		return changed, nil
+	}
+	res.Status.Phase = constants.ConditionReady
+	return changed, r.Status().Update(ctx, res)
+}
+
+func (r *MailcowReconciler) setDegraded(ctx context.Context, res *mailcowv1.Mailcow, reason, message string) (bool, error) {
+	changed := helpers.SetConditionStatus(&res.Status.Conditions, constants.ConditionDegraded, reason, message, res.Generation)
+	if !changed {
+		return changed, nil
+	}
+	res.Status.Phase = constants.ConditionDegraded
+	return changed, r.Status().Update(ctx, res)
+}
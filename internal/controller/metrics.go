@@ -0,0 +1,258 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	mailcowv1 "github.com/tarteo/mailcow-operator/api/v1"
+	"github.com/tarteo/mailcow-operator/mailcow"
+)
+
+const (
+	metricsNamespace = "mailcow"
+	// managedObjectsTimeout limits the time listing the managed objects may take during a scrape.
+	managedObjectsTimeout = 10 * time.Second
+)
+
+var (
+	apiRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Name:      "api_request_duration_seconds",
+		Help:      "Duration of the requests sent to the mailcow API.",
+		Buckets:   []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30},
+	}, []string{"namespace", "mailcow", "endpoint", "method", "status"})
+
+	apiRequestErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "api_request_errors_total",
+		Help:      "Number of requests to the mailcow API that failed, by reason.",
+	}, []string{"namespace", "mailcow", "endpoint", "method", "reason"})
+
+	mailboxQuota = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "mailbox_quota_bytes",
+		Help:      "Quota of a mailbox in bytes, 0 means unlimited.",
+	}, []string{"namespace", "name", "address"})
+
+	mailboxQuotaUsed = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "mailbox_quota_used_bytes",
+		Help:      "Used storage of a mailbox in bytes.",
+	}, []string{"namespace", "name", "address"})
+
+	queueMessages = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "queue_messages",
+		Help:      "Number of messages in the mail queue of a mailcow, by postfix queue.",
+	}, []string{"namespace", "mailcow", "queue"})
+
+	quarantineMessages = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "quarantine_messages",
+		Help:      "Number of messages in the quarantine of a mailcow.",
+	}, []string{"namespace", "mailcow"})
+)
+
+func init() {
+	metrics.Registry.MustRegister(apiRequestDuration, apiRequestErrors, mailboxQuota, mailboxQuotaUsed, queueMessages, quarantineMessages)
+}
+
+// observeRequests returns a request observer that records the requests sent to a mailcow.
+func observeRequests(name types.NamespacedName) mailcow.RequestObserver {
+	return func(req *http.Request, statusCode int, duration time.Duration, err error) {
+		endpoint := mailcow.EndpointForPath(req.URL.Path)
+		apiRequestDuration.WithLabelValues(name.Namespace, name.Name, endpoint, req.Method, strconv.Itoa(statusCode)).Observe(duration.Seconds())
+		if err != nil {
+			apiRequestErrors.WithLabelValues(name.Namespace, name.Name, endpoint, req.Method, errorReason(err, "Unknown")).Inc()
+		}
+	}
+}
+
+// setQueueMessages replaces the queue metrics of a mailcow, so queues that became empty are dropped.
+func setQueueMessages(name types.NamespacedName, queues map[string]int) {
+	queueMessages.DeletePartialMatch(prometheus.Labels{"namespace": name.Namespace, "mailcow": name.Name})
+	for queue, n := range queues {
+		queueMessages.WithLabelValues(name.Namespace, name.Name, queue).Set(float64(n))
+	}
+}
+
+// setMailboxQuotaMetrics records the quota and the used storage mailcow returned for a mailbox.
+func setMailboxQuotaMetrics(name types.NamespacedName, address string, live *mailcow.Mailbox) {
+	// Drop the metrics of a previous address of the mailbox
+	forgetMailboxMetrics(name)
+	if live.Quota != nil {
+		mailboxQuota.WithLabelValues(name.Namespace, name.Name, address).Set(float64(*live.Quota))
+	}
+	if live.QuotaUsed != nil {
+		mailboxQuotaUsed.WithLabelValues(name.Namespace, name.Name, address).Set(float64(*live.QuotaUsed))
+	}
+}
+
+// forgetMailcowMetrics removes the metrics of a deleted Mailcow resource.
+func forgetMailcowMetrics(name types.NamespacedName) {
+	labels := prometheus.Labels{"namespace": name.Namespace, "mailcow": name.Name}
+	apiRequestDuration.DeletePartialMatch(labels)
+	apiRequestErrors.DeletePartialMatch(labels)
+	queueMessages.DeletePartialMatch(labels)
+	quarantineMessages.DeletePartialMatch(labels)
+}
+
+// forgetMailboxMetrics removes the metrics of a deleted Mailbox resource.
+func forgetMailboxMetrics(name types.NamespacedName) {
+	labels := prometheus.Labels{"namespace": name.Namespace, "name": name.Name}
+	mailboxQuota.DeletePartialMatch(labels)
+	mailboxQuotaUsed.DeletePartialMatch(labels)
+}
+
+// ManagedObjectsCollector exports the number of managed resources by kind and phase.
+// The resources are counted from the cache on every scrape, so the numbers are never stale.
+type ManagedObjectsCollector struct {
+	reader client.Reader
+	desc   *prometheus.Desc
+}
+
+// NewManagedObjectsCollector returns a collector counting the resources read through reader.
+func NewManagedObjectsCollector(reader client.Reader) *ManagedObjectsCollector {
+	return &ManagedObjectsCollector{
+		reader: reader,
+		desc: prometheus.NewDesc(
+			prometheus.BuildFQName(metricsNamespace, "", "managed_objects"),
+			"Number of resources managed by the operator, by kind and phase.",
+			[]string{"namespace", "kind", "phase"}, nil,
+		),
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (c *ManagedObjectsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+// Collect implements prometheus.Collector.
+func (c *ManagedObjectsCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), managedObjectsTimeout)
+	defer cancel()
+
+	type key struct{ namespace, kind, phase string }
+	counts := make(map[key]int)
+	count := func(kind, namespace, phase string) {
+		if phase == "" {
+			phase = "Unknown"
+		}
+		counts[key{namespace, kind, phase}]++
+	}
+
+	var domains mailcowv1.DomainList
+	var mailboxes mailcowv1.MailboxList
+	var aliases mailcowv1.AliasList
+	var domainAdmins mailcowv1.DomainAdminList
+	var syncJobs mailcowv1.SyncJobList
+	var appPasswords mailcowv1.AppPasswordList
+	var bccMaps mailcowv1.BCCMapList
+	var recipientMaps mailcowv1.RecipientMapList
+	var tlsPolicyMaps mailcowv1.TLSPolicyMapList
+	var relayHosts mailcowv1.RelayHostList
+	var transportMaps mailcowv1.TransportMapList
+	var domainPolicies mailcowv1.DomainPolicyList
+	lists := []struct {
+		kind  string
+		list  client.ObjectList
+		count func(kind string)
+	}{
+		{"Domain", &domains, func(kind string) {
+			for _, obj := range domains.Items {
+				count(kind, obj.Namespace, obj.Status.Phase)
+			}
+		}},
+		{"Mailbox", &mailboxes, func(kind string) {
+			for _, obj := range mailboxes.Items {
+				count(kind, obj.Namespace, obj.Status.Phase)
+			}
+		}},
+		{"Alias", &aliases, func(kind string) {
+			for _, obj := range aliases.Items {
+				count(kind, obj.Namespace, obj.Status.Phase)
+			}
+		}},
+		{"DomainAdmin", &domainAdmins, func(kind string) {
+			for _, obj := range domainAdmins.Items {
+				count(kind, obj.Namespace, obj.Status.Phase)
+			}
+		}},
+		{"SyncJob", &syncJobs, func(kind string) {
+			for _, obj := range syncJobs.Items {
+				count(kind, obj.Namespace, obj.Status.Phase)
+			}
+		}},
+		{"AppPassword", &appPasswords, func(kind string) {
+			for _, obj := range appPasswords.Items {
+				count(kind, obj.Namespace, obj.Status.Phase)
+			}
+		}},
+		{"BCCMap", &bccMaps, func(kind string) {
+			for _, obj := range bccMaps.Items {
+				count(kind, obj.Namespace, obj.Status.Phase)
+			}
+		}},
+		{"RecipientMap", &recipientMaps, func(kind string) {
+			for _, obj := range recipientMaps.Items {
+				count(kind, obj.Namespace, obj.Status.Phase)
+			}
+		}},
+		{"TLSPolicyMap", &tlsPolicyMaps, func(kind string) {
+			for _, obj := range tlsPolicyMaps.Items {
+				count(kind, obj.Namespace, obj.Status.Phase)
+			}
+		}},
+		{"RelayHost", &relayHosts, func(kind string) {
+			for _, obj := range relayHosts.Items {
+				count(kind, obj.Namespace, obj.Status.Phase)
+			}
+		}},
+		{"TransportMap", &transportMaps, func(kind string) {
+			for _, obj := range transportMaps.Items {
+				count(kind, obj.Namespace, obj.Status.Phase)
+			}
+		}},
+		{"DomainPolicy", &domainPolicies, func(kind string) {
+			for _, obj := range domainPolicies.Items {
+				count(kind, obj.Namespace, obj.Status.Phase)
+			}
+		}},
+	}
+	for _, l := range lists {
+		if err := c.reader.List(ctx, l.list); err != nil {
+			log.FromContext(ctx).Error(err, "unable to list managed objects", "kind", l.kind)
+			continue
+		}
+		l.count(l.kind)
+	}
+
+	for k, n := range counts {
+		ch <- prometheus.MustNewConstMetric(c.desc, prometheus.GaugeValue, float64(n), k.namespace, k.kind, k.phase)
+	}
+}
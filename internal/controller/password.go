@@ -0,0 +1,146 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	mailcowv1 "github.com/tarteo/mailcow-operator/api/v1"
+	helpers "github.com/tarteo/mailcow-operator/helpers"
+)
+
+// defaultGeneratedPasswordLength is the length of a generated password when the policy doesn't set one.
+const defaultGeneratedPasswordLength = 24
+
+// ensurePasswordSecret creates the password secret of a resource with a generated password when it doesn't exist.
+// The secret is owned by the resource until a deletion keeps the object in mailcow, see releasePasswordSecret. An
+// existing secret is never overwritten, only a secret owned by the resource gets a missing key generated and its labels
+// updated. It returns the generated password, so it can be used before the secret shows up in the cache, or an empty
+// string when no password was generated.
+func ensurePasswordSecret(ctx context.Context, c client.Client, recorder record.EventRecorder, owner client.Object, kind string, selector corev1.SecretKeySelector, generate *mailcowv1.GeneratePassword) (string, error) {
+	if generate == nil {
+		return "", nil
+	}
+	log := log.FromContext(ctx)
+
+	var secret corev1.Secret
+	if err := c.Get(ctx, types.NamespacedName{Name: selector.Name, Namespace: owner.GetNamespace()}, &secret); err != nil {
+		if !errors.IsNotFound(err) {
+			return "", err
+		}
+
+		password, err := generatePassword(generate)
+		if err != nil {
+			return "", err
+		}
+		secret = corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      selector.Name,
+				Namespace: owner.GetNamespace(),
+				Labels:    generate.Labels,
+				OwnerReferences: []metav1.OwnerReference{
+					*metav1.NewControllerRef(owner, mailcowv1.GroupVersion.WithKind(kind)),
+				},
+			},
+			Type: corev1.SecretTypeOpaque,
+			Data: map[string][]byte{selector.Key: []byte(password)},
+		}
+		if err := c.Create(ctx, &secret); err != nil {
+			return "", err
+		}
+		log.Info("created password secret", "secret", secret.Name)
+		recorder.Eventf(owner, corev1.EventTypeNormal, "PasswordGenerated", "Generated a password into secret %s", secret.Name)
+		return password, nil
+	}
+
+	if !metav1.IsControlledBy(&secret, owner) {
+		return "", nil
+	}
+
+	changed := false
+	password := ""
+	if _, ok := secret.Data[selector.Key]; !ok {
+		var err error
+		password, err = generatePassword(generate)
+		if err != nil {
+			return "", err
+		}
+		if secret.Data == nil {
+			secret.Data = map[string][]byte{}
+		}
+		secret.Data[selector.Key] = []byte(password)
+		recorder.Eventf(owner, corev1.EventTypeNormal, "PasswordGenerated", "Generated a password into key %s of secret %s", selector.Key, secret.Name)
+		changed = true
+	}
+	labels := maps.Clone(secret.Labels)
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	maps.Copy(labels, generate.Labels)
+	if !maps.Equal(labels, secret.Labels) {
+		secret.Labels = labels
+		changed = true
+	}
+	if !changed {
+		return "", nil
+	}
+	if err := c.Update(ctx, &secret); err != nil {
+		return "", err
+	}
+	return password, nil
+}
+
+// releasePasswordSecret removes the owner reference of the resource from its generated password secret, so the secret
+// isn't garbage collected when the resource is deleted while its object stays in mailcow.
+func releasePasswordSecret(ctx context.Context, c client.Client, recorder record.EventRecorder, owner client.Object, selector corev1.SecretKeySelector) error {
+	var secret corev1.Secret
+	if err := c.Get(ctx, types.NamespacedName{Name: selector.Name, Namespace: owner.GetNamespace()}, &secret); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	if !metav1.IsControlledBy(&secret, owner) {
+		return nil
+	}
+
+	secret.OwnerReferences = slices.DeleteFunc(secret.OwnerReferences, func(ref metav1.OwnerReference) bool {
+		return ref.UID == owner.GetUID()
+	})
+	if err := c.Update(ctx, &secret); err != nil {
+		return err
+	}
+	log.FromContext(ctx).Info("released password secret", "secret", secret.Name)
+	recorder.Eventf(owner, corev1.EventTypeNormal, "PasswordRetained", "Kept secret %s with the password of the object retained in mailcow", secret.Name)
+	return nil
+}
+
+// generatePassword returns a password generated with the length and charset of the policy.
+func generatePassword(generate *mailcowv1.GeneratePassword) (string, error) {
+	length := int(generate.Length)
+	if length == 0 {
+		length = defaultGeneratedPasswordLength
+	}
+	return helpers.GenerateStrongPassword(length, generate.Charset == mailcowv1.PasswordCharsetSpecial)
+}
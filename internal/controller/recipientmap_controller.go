@@ -0,0 +1,341 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	mailcowv1 "github.com/tarteo/mailcow-operator/api/v1"
+	constants "github.com/tarteo/mailcow-operator/common"
+	helpers "github.com/tarteo/mailcow-operator/helpers"
+	"github.com/tarteo/mailcow-operator/mailcow"
+)
+
+// RecipientMapReconciler reconciles a RecipientMap object
+type RecipientMapReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Clients  *MailcowClients
+	Recorder record.EventRecorder
+}
+
+// +kubebuilder:rbac:groups=mailcow.onestein.nl,resources=recipientmaps,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=mailcow.onestein.nl,resources=recipientmaps/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=mailcow.onestein.nl,resources=recipientmaps/finalizers,verbs=update
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+// Reconcile creates, updates and deletes the recipient map in mailcow.
+//
+// For more details, check Reconcile and its Result here:
+// - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.19.1/pkg/reconcile
+func (r *RecipientMapReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := log.FromContext(ctx).WithValues("namespace", req.NamespacedName)
+	log.Info("reconciling recipientmap")
+
+	var recipientMap mailcowv1.RecipientMap
+	if err := r.Get(ctx, req.NamespacedName, &recipientMap); err != nil {
+		if errors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		log.Error(err, "unable to find recipientmap")
+		return ctrl.Result{}, err
+	}
+
+	// Apply finalizer
+	if recipientMap.ObjectMeta.DeletionTimestamp.IsZero() {
+		if !controllerutil.ContainsFinalizer(&recipientMap, constants.Finalizer) {
+			controllerutil.AddFinalizer(&recipientMap, constants.Finalizer)
+			if err := r.Update(ctx, &recipientMap); err != nil {
+				log.Error(err, "unable to update recipientmap with finalizer")
+				return ctrl.Result{}, err
+			}
+
+			// Return and requeue to get fresh object
+			return ctrl.Result{Requeue: true}, nil
+		}
+		// Set progressing status
+		if changed, err := r.setProgressing(ctx, &recipientMap, "Reconciling recipient map"); err != nil {
+			log.Error(err, "unable to set progressing status")
+			return ctrl.Result{}, err
+		} else if changed {
+			// Requeue to get fresh object with updated status
+			return ctrl.Result{Requeue: true}, nil
+		}
+	}
+
+	// Reconcile the resource
+	if err := r.ReconcileResource(ctx, &recipientMap); err != nil {
+		log.Error(err, "unable to reconcile mailcow recipientmap")
+		// Set degraded status
+		changed, errStatus := r.setDegraded(ctx, &recipientMap, errorReason(err, "ReconcileFailed"), err.Error())
+		if errStatus != nil {
+			log.Error(errStatus, "unable to set degraded status")
+			return ctrl.Result{}, errStatus
+		}
+		// Only record the error when it changed, so requeues don't repeat the same event
+		if changed {
+			recordError(r.Recorder, &recipientMap, err)
+		}
+		return handleReconcileError(ctx, r.Client, recipientMap.Namespace, recipientMap.GetMailcowRef(), err)
+	}
+
+	// Remove finalizer if deletion timestamp is set
+	if !recipientMap.ObjectMeta.DeletionTimestamp.IsZero() && controllerutil.ContainsFinalizer(&recipientMap, constants.Finalizer) {
+		controllerutil.RemoveFinalizer(&recipientMap, constants.Finalizer)
+		if err := r.Update(ctx, &recipientMap); err != nil {
+			log.Error(err, "unable to update recipientmap with finalizer")
+			return ctrl.Result{}, err
+		}
+
+		return ctrl.Result{}, nil
+	}
+
+	// Set ready status
+	if _, err := r.setReady(ctx, &recipientMap, "Recipient map successfully reconciled"); err != nil {
+		log.Error(err, "unable to set ready status")
+		return ctrl.Result{}, err
+	}
+
+	// Requeue to detect drift in mailcow
+	return ctrl.Result{RequeueAfter: resyncInterval(ctx, r, recipientMap.Namespace, recipientMap.GetMailcowRef(), recipientMap.Spec.ResyncInterval)}, nil
+}
+
+func (r *RecipientMapReconciler) ReconcileResource(ctx context.Context, recipientMap *mailcowv1.RecipientMap) error {
+	log := log.FromContext(ctx).WithValues("namespace", types.NamespacedName{Namespace: recipientMap.Namespace, Name: recipientMap.Name})
+	var err error
+
+	// A recipientmap retained by its own deletionPolicy needs no mailcow, so it can be deleted after its mailcow
+	if !recipientMap.ObjectMeta.DeletionTimestamp.IsZero() && recipientMap.Spec.DeletionPolicy == mailcowv1.DeletionPolicyRetain {
+		log.Info("retaining recipientmap in mailcow")
+		return nil
+	}
+
+	// Get related mailcow resource
+	res, err := mailcowv1.GetMailcow(ctx, r, recipientMap.Namespace, recipientMap.GetMailcowRef())
+	if err != nil {
+		// On deletion, e.g. during a namespace teardown, a mailcow that is gone leaves nothing to clean up
+		if !recipientMap.ObjectMeta.DeletionTimestamp.IsZero() && errors.IsNotFound(err) {
+			log.Info("leaving recipientmap untouched, its mailcow no longer exists")
+			return nil
+		}
+		log.Error(err, "unable to find related mailcow resource", "mailcow", recipientMap.GetMailcowRef().String())
+		return err
+	}
+
+	deleting := !recipientMap.ObjectMeta.DeletionTimestamp.IsZero()
+	oldRecipient := recipientMap.Spec.OldRecipient
+
+	// Objects in a domain of another namespace are never touched in mailcow, also not on deletion
+	if err := checkDomainOwnership(ctx, r, recipientMap.Namespace, recipientMap.GetMailcowRef(), localDestDomain(oldRecipient)); err != nil {
+		if deleting && isDomainNotAllowed(err) {
+			log.Info("leaving recipientmap in a domain not owned by the namespace untouched in mailcow")
+			return nil
+		}
+		log.Error(err, "unable to use the domain of the recipientmap")
+		return err
+	}
+
+	// Retain leaves the recipientmap in mailcow untouched on deletion
+	deletionPolicy := res.GetDeletionPolicy(recipientMap.Spec.DeletionPolicy)
+	if deleting && deletionPolicy == mailcowv1.DeletionPolicyRetain {
+		log.Info("retaining recipientmap in mailcow")
+		return nil
+	}
+
+	// Reconcile mailcow recipientmap
+	client, err := r.Clients.Get(ctx, r, res)
+	if err != nil {
+		log.Error(err, "unable to create mailcow client")
+		return err
+	}
+
+	live, err := r.findRecipientMap(ctx, client, recipientMap)
+	if err != nil {
+		log.Error(err, "unable to get recipientmaps")
+		return err
+	}
+
+	if deleting {
+		// Handle deletion
+		if live != nil && deletionPolicy == mailcowv1.DeletionPolicyDisable {
+			active := false
+			_, err = client.UpdateRecipientMapWithResponse(ctx, mailcow.UpdateRecipientMapJSONRequestBody{
+				Attr:  &mailcow.EditRecipientMapAttr{Active: &active},
+				Items: &[]string{strconv.Itoa(*live.Id)},
+			})
+			if err != nil {
+				log.Error(err, "unable to disable recipientmap")
+				return err
+			}
+			r.Recorder.Eventf(recipientMap, corev1.EventTypeNormal, "Disabled", "Disabled recipient map of %s in mailcow", oldRecipient)
+		} else if live != nil {
+			_, err = client.DeleteRecipientMapWithResponse(ctx, mailcow.DeleteRecipientMapJSONRequestBody{strconv.Itoa(*live.Id)})
+			if err != nil {
+				log.Error(err, "unable to delete recipientmap")
+				return err
+			}
+			r.Recorder.Eventf(recipientMap, corev1.EventTypeNormal, "Deleted", "Deleted recipient map of %s from mailcow", oldRecipient)
+		}
+		return nil
+	}
+
+	active := recipientMap.Spec.Active == nil || *recipientMap.Spec.Active
+
+	if live == nil {
+		// Adopted recipientmaps are never recreated
+		if recipientMap.Annotations[constants.AnnotationAdopt] == "true" {
+			return fmt.Errorf("adopted recipient map of %s does not exist in mailcow", oldRecipient)
+		}
+
+		// Recipient map does not exist, create it
+		_, err = client.CreateRecipientMapWithResponse(ctx, mailcow.CreateRecipientMapJSONRequestBody{
+			Active:          helpers.BoolToFloat32(&active),
+			RecipientMapNew: &recipientMap.Spec.NewRecipient,
+			RecipientMapOld: &oldRecipient,
+		})
+		if err != nil {
+			log.Error(err, "unable to create recipientmap")
+			return err
+		}
+
+		// Mailcow doesn't return the id of the created recipient map, look it up
+		live, err = r.findRecipientMap(ctx, client, recipientMap)
+		if err != nil {
+			log.Error(err, "unable to get created recipientmap")
+			return err
+		}
+		r.Recorder.Eventf(recipientMap, corev1.EventTypeNormal, "Created", "Created recipient map of %s in mailcow", oldRecipient)
+	} else {
+		// Recipient map exists, compare it against the spec
+		var drifted []string
+		if liveActive := live.Active.String(); liveActive != "" && helpers.BoolStringDrifted(&active, &liveActive) {
+			drifted = append(drifted, "active")
+		}
+		if live.RecipientMapNew != nil && !strings.EqualFold(*live.RecipientMapNew, recipientMap.Spec.NewRecipient) {
+			drifted = append(drifted, "newRecipient")
+		}
+
+		if err := recordDrift(ctx, r.Client, r.Recorder, recipientMap, &recipientMap.Status.Conditions, drifted); err != nil {
+			log.Error(err, "unable to record drift")
+			return err
+		}
+
+		if len(drifted) > 0 || !helpers.IsReconciled(recipientMap.Status.Conditions, recipientMap.Generation) {
+			// Recipient map drifted or the spec changed, update it
+			_, err = client.UpdateRecipientMapWithResponse(ctx, mailcow.UpdateRecipientMapJSONRequestBody{
+				Attr: &mailcow.EditRecipientMapAttr{
+					Active:          &active,
+					RecipientMapNew: &recipientMap.Spec.NewRecipient,
+					RecipientMapOld: &oldRecipient,
+				},
+				Items: &[]string{strconv.Itoa(*live.Id)},
+			})
+			if err != nil {
+				log.Error(err, "unable to update recipientmap")
+				return err
+			}
+			r.Recorder.Eventf(recipientMap, corev1.EventTypeNormal, "Updated", "Updated recipient map of %s in mailcow", oldRecipient)
+		}
+	}
+
+	var id *int
+	if live != nil {
+		id = live.Id
+	}
+	if !helpers.IntPtrEqual(recipientMap.Status.ID, id) {
+		recipientMap.Status.ID = id
+		if err := r.Status().Update(ctx, recipientMap); err != nil {
+			log.Error(err, "unable to update recipientmap status")
+			return err
+		}
+	}
+
+	return nil
+}
+
+// findRecipientMap returns the recipient map in mailcow by the id in the status, or by its old recipient.
+func (r *RecipientMapReconciler) findRecipientMap(ctx context.Context, client *mailcow.ClientWithResponses, recipientMap *mailcowv1.RecipientMap) (*mailcow.RecipientMap, error) {
+	maps, err := client.ListRecipientMaps(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, m := range maps {
+		if m.Id == nil {
+			continue
+		}
+		if recipientMap.Status.ID != nil {
+			if *m.Id == *recipientMap.Status.ID {
+				return &maps[i], nil
+			}
+			continue
+		}
+		if m.RecipientMapOld != nil && strings.EqualFold(*m.RecipientMapOld, recipientMap.Spec.OldRecipient) {
+			return &maps[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *RecipientMapReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&mailcowv1.RecipientMap{}).
+		Named("recipientmap").
+		Complete(r)
+}
+
+func (r *RecipientMapReconciler) setProgressing(ctx context.Context, recipientMap *mailcowv1.RecipientMap, message string) (bool, error) {
+	changed := helpers.SetConditionStatus(&recipientMap.Status.Conditions, constants.ConditionProgressing, "Reconciling", message, recipientMap.Generation)
+	if !changed {
+		return changed, nil
+	}
+	recipientMap.Status.Phase = constants.ConditionProgressing
+	return changed, r.Status().Update(ctx, recipientMap)
+}
+
+func (r *RecipientMapReconciler) setReady(ctx context.Context, recipientMap *mailcowv1.RecipientMap, message string) (bool, error) {
+	changed := helpers.SetConditionStatus(&recipientMap.Status.Conditions, constants.ConditionReady, "Reconciled", message, recipientMap.Generation)
+	if !changed {
+		return changed, nil
+	}
+	recipientMap.Status.Phase = constants.ConditionReady
+	return changed, r.Status().Update(ctx, recipientMap)
+}
+
+func (r *RecipientMapReconciler) setDegraded(ctx context.Context, recipientMap *mailcowv1.RecipientMap, reason, message string) (bool, error) {
+	changed := helpers.SetConditionStatus(&recipientMap.Status.Conditions, constants.ConditionDegraded, reason, message, recipientMap.Generation)
+	if !changed {
+		return changed, nil
+	}
+	recipientMap.Status.Phase = constants.ConditionDegraded
+	return changed, r.Status().Update(ctx, recipientMap)
+}
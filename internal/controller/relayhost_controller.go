@@ -0,0 +1,391 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	mailcowv1 "github.com/tarteo/mailcow-operator/api/v1"
+	constants "github.com/tarteo/mailcow-operator/common"
+	helpers "github.com/tarteo/mailcow-operator/helpers"
+	"github.com/tarteo/mailcow-operator/mailcow"
+)
+
+// RelayHostReconciler reconciles a RecipientMap object
+type RelayHostReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Clients  *MailcowClients
+	Recorder record.EventRecorder
+}
+
+// +kubebuilder:rbac:groups=mailcow.onestein.nl,resources=relayhosts,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=mailcow.onestein.nl,resources=relayhosts/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=mailcow.onestein.nl,resources=relayhosts/finalizers,verbs=update
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+// Reconcile creates, updates and deletes the recipient map in mailcow.
+//
+// For more details, check Reconcile and its Result here:
+// - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.19.1/pkg/reconcile
+func (r *RelayHostReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := log.FromContext(ctx).WithValues("namespace", req.NamespacedName)
+	log.Info("reconciling relayhost")
+
+	var relayhost mailcowv1.RelayHost
+	if err := r.Get(ctx, req.NamespacedName, &relayhost); err != nil {
+		if errors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		log.Error(err, "unable to find relayhost")
+		return ctrl.Result{}, err
+	}
+
+	// Apply finalizer
+	if relayhost.ObjectMeta.DeletionTimestamp.IsZero() {
+		if !controllerutil.ContainsFinalizer(&relayhost, constants.Finalizer) {
+			controllerutil.AddFinalizer(&relayhost, constants.Finalizer)
+			if err := r.Update(ctx, &relayhost); err != nil {
+				log.Error(err, "unable to update relayhost with finalizer")
+				return ctrl.Result{}, err
+			}
+
+			// Return and requeue to get fresh object
+			return ctrl.Result{Requeue: true}, nil
+		}
+		// Set progressing status
+		if changed, err := r.setProgressing(ctx, &relayhost, "Reconciling relayhost"); err != nil {
+			log.Error(err, "unable to set progressing status")
+			return ctrl.Result{}, err
+		} else if changed {
+			// Requeue to get fresh object with updated status
+			return ctrl.Result{Requeue: true}, nil
+		}
+	}
+
+	// Reconcile the resource
+	if err := r.ReconcileResource(ctx, &relayhost); err != nil {
+		log.Error(err, "unable to reconcile mailcow relayhost")
+		// Set degraded status
+		changed, errStatus := r.setDegraded(ctx, &relayhost, errorReason(err, "ReconcileFailed"), err.Error())
+		if errStatus != nil {
+			log.Error(errStatus, "unable to set degraded status")
+			return ctrl.Result{}, errStatus
+		}
+		// Only record the error when it changed, so requeues don't repeat the same event
+		if changed {
+			recordError(r.Recorder, &relayhost, err)
+		}
+		return handleReconcileError(ctx, r.Client, relayhost.Namespace, relayhost.GetMailcowRef(), err)
+	}
+
+	// Remove finalizer if deletion timestamp is set
+	if !relayhost.ObjectMeta.DeletionTimestamp.IsZero() && controllerutil.ContainsFinalizer(&relayhost, constants.Finalizer) {
+		controllerutil.RemoveFinalizer(&relayhost, constants.Finalizer)
+		if err := r.Update(ctx, &relayhost); err != nil {
+			log.Error(err, "unable to update relayhost with finalizer")
+			return ctrl.Result{}, err
+		}
+
+		return ctrl.Result{}, nil
+	}
+
+	// Set ready status
+	if _, err := r.setReady(ctx, &relayhost, "Relayhost successfully reconciled"); err != nil {
+		log.Error(err, "unable to set ready status")
+		return ctrl.Result{}, err
+	}
+
+	// Requeue to detect drift in mailcow
+	return ctrl.Result{RequeueAfter: resyncInterval(ctx, r, relayhost.Namespace, relayhost.GetMailcowRef(), relayhost.Spec.ResyncInterval)}, nil
+}
+
+func (r *RelayHostReconciler) ReconcileResource(ctx context.Context, relayhost *mailcowv1.RelayHost) error {
+	log := log.FromContext(ctx).WithValues("namespace", types.NamespacedName{Namespace: relayhost.Namespace, Name: relayhost.Name})
+	var err error
+
+	// A relayhost retained by its own deletionPolicy needs no mailcow, so it can be deleted after its mailcow
+	if !relayhost.ObjectMeta.DeletionTimestamp.IsZero() && relayhost.Spec.DeletionPolicy == mailcowv1.DeletionPolicyRetain {
+		log.Info("retaining relayhost in mailcow")
+		return nil
+	}
+
+	// Get related mailcow resource
+	res, err := mailcowv1.GetMailcow(ctx, r, relayhost.Namespace, relayhost.GetMailcowRef())
+	if err != nil {
+		// On deletion, e.g. during a namespace teardown, a mailcow that is gone leaves nothing to clean up
+		if !relayhost.ObjectMeta.DeletionTimestamp.IsZero() && errors.IsNotFound(err) {
+			log.Info("leaving relayhost untouched, its mailcow no longer exists")
+			return nil
+		}
+		log.Error(err, "unable to find related mailcow resource", "mailcow", relayhost.GetMailcowRef().String())
+		return err
+	}
+
+	deleting := !relayhost.ObjectMeta.DeletionTimestamp.IsZero()
+	hostname := relayhost.Spec.Hostname
+
+	// Retain leaves the relayhost in mailcow untouched on deletion
+	deletionPolicy := res.GetDeletionPolicy(relayhost.Spec.DeletionPolicy)
+	if deleting && deletionPolicy == mailcowv1.DeletionPolicyRetain {
+		log.Info("retaining relayhost in mailcow")
+		return nil
+	}
+
+	// Reconcile mailcow relayhost
+	client, err := r.Clients.Get(ctx, r, res)
+	if err != nil {
+		log.Error(err, "unable to create mailcow client")
+		return err
+	}
+
+	live, err := r.findRelayhost(ctx, client, relayhost)
+	if err != nil {
+		log.Error(err, "unable to get relayhosts")
+		return err
+	}
+
+	if deleting {
+		// Handle deletion
+		if live != nil && deletionPolicy == mailcowv1.DeletionPolicyDisable {
+			active := false
+			_, err = client.UpdateSenderDependentTransportsWithResponse(ctx, mailcow.UpdateSenderDependentTransportsJSONRequestBody{
+				Attr:  &mailcow.EditRelayhostAttr{Active: &active},
+				Items: &[]string{strconv.Itoa(*live.Id)},
+			})
+			if err != nil {
+				log.Error(err, "unable to disable relayhost")
+				return err
+			}
+			r.Recorder.Eventf(relayhost, corev1.EventTypeNormal, "Disabled", "Disabled relayhost %s in mailcow", hostname)
+		} else if live != nil {
+			_, err = client.DeleteSenderDependentTransportsWithResponse(ctx, mailcow.DeleteSenderDependentTransportsJSONRequestBody{strconv.Itoa(*live.Id)})
+			if err != nil {
+				log.Error(err, "unable to delete relayhost")
+				return err
+			}
+			r.Recorder.Eventf(relayhost, corev1.EventTypeNormal, "Deleted", "Deleted relayhost %s from mailcow", hostname)
+		}
+		return nil
+	}
+
+	password, err := relayhost.GetPassword(ctx, r)
+	if err != nil {
+		log.Error(err, "unable to get password from secret")
+		return err
+	}
+
+	// Salt with the uid, so the hash in the status can't be compared across resources
+	passwordHash := helpers.Hash(string(relayhost.UID), password)
+	active := relayhost.Spec.Active == nil || *relayhost.Spec.Active
+
+	if live == nil {
+		// Adopted relayhosts are never recreated
+		if relayhost.Annotations[constants.AnnotationAdopt] == "true" {
+			return fmt.Errorf("adopted relayhost %s does not exist in mailcow", hostname)
+		}
+
+		// Relayhost does not exist, create it
+		_, err = client.CreateSenderDependentTransportsWithResponse(ctx, mailcow.CreateSenderDependentTransportsJSONRequestBody{
+			Hostname: &hostname,
+			Username: &relayhost.Spec.Username,
+			Password: &password,
+		})
+		if err != nil {
+			log.Error(err, "unable to create relayhost")
+			return err
+		}
+
+		// Mailcow doesn't return the id of the created relayhost, look it up
+		live, err = r.findRelayhost(ctx, client, relayhost)
+		if err != nil {
+			log.Error(err, "unable to get created relayhost")
+			return err
+		}
+		if live == nil {
+			return fmt.Errorf("created relayhost %s not found in mailcow", hostname)
+		}
+		r.Recorder.Eventf(relayhost, corev1.EventTypeNormal, "Created", "Created relayhost %s in mailcow", hostname)
+
+		// Relayhosts are always created active
+		if !active {
+			_, err = client.UpdateSenderDependentTransportsWithResponse(ctx, mailcow.UpdateSenderDependentTransportsJSONRequestBody{
+				Attr:  &mailcow.EditRelayhostAttr{Active: &active},
+				Items: &[]string{strconv.Itoa(*live.Id)},
+			})
+			if err != nil {
+				log.Error(err, "unable to disable created relayhost")
+				return err
+			}
+		}
+	} else {
+		// Relayhost exists, compare it against the spec
+		passwordChanged := relayhost.Status.PasswordHash != passwordHash
+		var drifted []string
+		if liveActive := live.Active.String(); liveActive != "" && helpers.BoolStringDrifted(&active, &liveActive) {
+			drifted = append(drifted, "active")
+		}
+		if live.Hostname != nil && !strings.EqualFold(*live.Hostname, hostname) {
+			drifted = append(drifted, "hostname")
+		}
+		if helpers.StringDrifted(relayhost.Spec.Username, live.Username) {
+			drifted = append(drifted, "username")
+		}
+		// A rotated secret is not drift, the new password is pushed below
+		if !passwordChanged && helpers.StringDrifted(password, live.Password) {
+			drifted = append(drifted, "password")
+		}
+
+		if err := recordDrift(ctx, r.Client, r.Recorder, relayhost, &relayhost.Status.Conditions, drifted); err != nil {
+			log.Error(err, "unable to record drift")
+			return err
+		}
+
+		if len(drifted) > 0 || passwordChanged || !helpers.IsReconciled(relayhost.Status.Conditions, relayhost.Generation) {
+			// Relayhost drifted, the secret or the spec changed, update it
+			if passwordChanged {
+				log.Info("password changed, updating relayhost password")
+			}
+			_, err = client.UpdateSenderDependentTransportsWithResponse(ctx, mailcow.UpdateSenderDependentTransportsJSONRequestBody{
+				Attr: &mailcow.EditRelayhostAttr{
+					Active:   &active,
+					Hostname: &hostname,
+					Username: &relayhost.Spec.Username,
+					Password: &password,
+				},
+				Items: &[]string{strconv.Itoa(*live.Id)},
+			})
+			if err != nil {
+				log.Error(err, "unable to update relayhost")
+				return err
+			}
+			r.Recorder.Eventf(relayhost, corev1.EventTypeNormal, "Updated", "Updated relayhost %s in mailcow", hostname)
+		}
+	}
+
+	if !helpers.IntPtrEqual(relayhost.Status.ID, live.Id) || relayhost.Status.PasswordHash != passwordHash {
+		relayhost.Status.ID = live.Id
+		relayhost.Status.PasswordHash = passwordHash
+		if err := r.Status().Update(ctx, relayhost); err != nil {
+			log.Error(err, "unable to update relayhost status")
+			return err
+		}
+	}
+
+	return nil
+}
+
+// findRelayhost returns the relayhost in mailcow by the id in the status, or by its hostname and username.
+func (r *RelayHostReconciler) findRelayhost(ctx context.Context, client *mailcow.ClientWithResponses, relayhost *mailcowv1.RelayHost) (*mailcow.Relayhost, error) {
+	relayhosts, err := client.ListRelayhosts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, h := range relayhosts {
+		if h.Id == nil {
+			continue
+		}
+		if relayhost.Status.ID != nil {
+			if *h.Id == *relayhost.Status.ID {
+				return &relayhosts[i], nil
+			}
+			continue
+		}
+		if h.Hostname != nil && strings.EqualFold(*h.Hostname, relayhost.Spec.Hostname) && !helpers.StringDrifted(relayhost.Spec.Username, h.Username) {
+			return &relayhosts[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *RelayHostReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &mailcowv1.RelayHost{}, passwordSecretIndex, func(obj client.Object) []string {
+		if selector := obj.(*mailcowv1.RelayHost).Spec.PasswordSecret; selector != nil {
+			return []string{selector.Name}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&mailcowv1.RelayHost{}).
+		Watches(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(r.findRelayHostsForSecret)).
+		Named("relayhost").
+		Complete(r)
+}
+
+// findRelayHostsForSecret returns a request for every relayhost that uses the secret as password secret.
+func (r *RelayHostReconciler) findRelayHostsForSecret(ctx context.Context, secret client.Object) []reconcile.Request {
+	var relayhosts mailcowv1.RelayHostList
+	if err := r.List(ctx, &relayhosts, client.InNamespace(secret.GetNamespace()), client.MatchingFields{passwordSecretIndex: secret.GetName()}); err != nil {
+		log.FromContext(ctx).Error(err, "unable to list relayhosts for secret", "secret", secret.GetName())
+		return nil
+	}
+
+	requests := make([]reconcile.Request, len(relayhosts.Items))
+	for i, relayhost := range relayhosts.Items {
+		requests[i] = reconcile.Request{NamespacedName: types.NamespacedName{Name: relayhost.Name, Namespace: relayhost.Namespace}}
+	}
+	return requests
+}
+
+func (r *RelayHostReconciler) setProgressing(ctx context.Context, relayhost *mailcowv1.RelayHost, message string) (bool, error) {
+	changed := helpers.SetConditionStatus(&relayhost.Status.Conditions, constants.ConditionProgressing, "Reconciling", message, relayhost.Generation)
+	if !changed {
+		return changed, nil
+	}
+	relayhost.Status.Phase = constants.ConditionProgressing
+	return changed, r.Status().Update(ctx, relayhost)
+}
+
+func (r *RelayHostReconciler) setReady(ctx context.Context, relayhost *mailcowv1.RelayHost, message string) (bool, error) {
+	changed := helpers.SetConditionStatus(&relayhost.Status.Conditions, constants.ConditionReady, "Reconciled", message, relayhost.Generation)
+	if !changed {
+		return changed, nil
+	}
+	relayhost.Status.Phase = constants.ConditionReady
+	return changed, r.Status().Update(ctx, relayhost)
+}
+
+func (r *RelayHostReconciler) setDegraded(ctx context.Context, relayhost *mailcowv1.RelayHost, reason, message string) (bool, error) {
+	changed := helpers.SetConditionStatus(&relayhost.Status.Conditions, constants.ConditionDegraded, reason, message, relayhost.Generation)
+	if !changed {
+		return changed, nil
+	}
+	relayhost.Status.Phase = constants.ConditionDegraded
+	return changed, r.Status().Update(ctx, relayhost)
+}
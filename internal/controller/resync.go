@@ -0,0 +1,71 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	mailcowv1 "github.com/tarteo/mailcow-operator/api/v1"
+	constants "github.com/tarteo/mailcow-operator/common"
+	helpers "github.com/tarteo/mailcow-operator/helpers"
+)
+
+// resyncInterval returns how long to wait before comparing the resource against mailcow again, 0 disables the resync.
+func resyncInterval(ctx context.Context, r client.Reader, namespace string, ref mailcowv1.MailcowReference, override *metav1.Duration) time.Duration {
+	res, err := mailcowv1.GetMailcow(ctx, r, namespace, ref)
+	if err != nil {
+		return 0
+	}
+	return res.GetResyncInterval(override)
+}
+
+// recordDrift records the fields that drifted from the spec in the Drifted condition and as an event.
+// Differences found before the current generation was reconciled are spec changes, not drift, and are ignored.
+func recordDrift(ctx context.Context, c client.Client, recorder record.EventRecorder, obj client.Object, conditions *[]metav1.Condition, drifted []string) error {
+	if !helpers.IsReconciled(*conditions, obj.GetGeneration()) {
+		return nil
+	}
+
+	condition := metav1.Condition{
+		Type:               constants.ConditionDrifted,
+		Status:             metav1.ConditionFalse,
+		Reason:             "InSync",
+		Message:            "No drift detected",
+		ObservedGeneration: obj.GetGeneration(),
+	}
+	if len(drifted) > 0 {
+		message := fmt.Sprintf("Corrected drift in %s", strings.Join(drifted, ", "))
+		recorder.Event(obj, corev1.EventTypeWarning, "Drifted", message)
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = "DriftCorrected"
+		condition.Message = message
+	}
+
+	if !meta.SetStatusCondition(conditions, condition) {
+		return nil
+	}
+	return c.Status().Update(ctx, obj)
+}
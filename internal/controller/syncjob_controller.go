@@ -0,0 +1,482 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	mailcowv1 "github.com/tarteo/mailcow-operator/api/v1"
+	constants "github.com/tarteo/mailcow-operator/common"
+	helpers "github.com/tarteo/mailcow-operator/helpers"
+	"github.com/tarteo/mailcow-operator/mailcow"
+)
+
+// SyncJobReconciler reconciles a SyncJob object
+type SyncJobReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Clients  *MailcowClients
+	Recorder record.EventRecorder
+}
+
+// +kubebuilder:rbac:groups=mailcow.onestein.nl,resources=syncjobs,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=mailcow.onestein.nl,resources=syncjobs/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=mailcow.onestein.nl,resources=syncjobs/finalizers,verbs=update
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+//
+// For more details, check Reconcile and its Result here:
+// - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.19.1/pkg/reconcile
+func (r *SyncJobReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := log.FromContext(ctx).WithValues("namespace", req.NamespacedName)
+	log.Info("reconciling syncjob")
+
+	var syncjob mailcowv1.SyncJob
+	if err := r.Get(ctx, req.NamespacedName, &syncjob); err != nil {
+		if errors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		log.Error(err, "unable to find syncjob")
+		return ctrl.Result{}, err
+	}
+
+	// Apply finalizer
+	if syncjob.ObjectMeta.DeletionTimestamp.IsZero() {
+		if !controllerutil.ContainsFinalizer(&syncjob, constants.Finalizer) {
+			controllerutil.AddFinalizer(&syncjob, constants.Finalizer)
+			if err := r.Update(ctx, &syncjob); err != nil {
+				log.Error(err, "unable to update syncjob with finalizer")
+				return ctrl.Result{}, err
+			}
+
+			// Return and requeue to get fresh object
+			return ctrl.Result{Requeue: true}, nil
+		}
+		// Set progressing status
+		if changed, err := r.setProgressing(ctx, &syncjob, "Reconciling syncjob"); err != nil {
+			log.Error(err, "unable to set progressing status")
+			return ctrl.Result{}, err
+		} else if changed {
+			// Requeue to get fresh object with updated status
+			return ctrl.Result{Requeue: true}, nil
+		}
+	}
+
+	if err := r.ReconcileResource(ctx, &syncjob); err != nil {
+		log.Error(err, "unable to reconcile mailcow syncjob")
+		// Set degraded status
+		changed, errStatus := r.setDegraded(ctx, &syncjob, errorReason(err, "ReconcileFailed"), err.Error())
+		if errStatus != nil {
+			log.Error(errStatus, "unable to set degraded status")
+			return ctrl.Result{}, errStatus
+		}
+		// Only record the error when it changed, so requeues don't repeat the same event
+		if changed {
+			recordError(r.Recorder, &syncjob, err)
+		}
+		return handleReconcileError(ctx, r.Client, syncjob.Namespace, mailboxMailcow(ctx, r, syncjob.Namespace, syncjob.Spec.Mailbox), err)
+	}
+
+	// Remove finalizer if deletion timestamp is set
+	if !syncjob.ObjectMeta.DeletionTimestamp.IsZero() && controllerutil.ContainsFinalizer(&syncjob, constants.Finalizer) {
+		controllerutil.RemoveFinalizer(&syncjob, constants.Finalizer)
+		if err := r.Update(ctx, &syncjob); err != nil {
+			log.Error(err, "unable to update syncjob with finalizer")
+			return ctrl.Result{}, err
+		}
+
+		return ctrl.Result{}, nil
+	}
+
+	// Set ready status
+	if _, err := r.setReady(ctx, &syncjob, "SyncJob successfully reconciled"); err != nil {
+		log.Error(err, "unable to set ready status")
+		return ctrl.Result{}, err
+	}
+
+	// Requeue to pick up the result of the next run
+	return ctrl.Result{RequeueAfter: time.Duration(syncjob.Spec.MinsInterval) * time.Minute}, nil
+}
+
+func (r *SyncJobReconciler) ReconcileResource(ctx context.Context, syncjob *mailcowv1.SyncJob) error {
+	log := log.FromContext(ctx).WithValues("namespace", types.NamespacedName{Namespace: syncjob.Namespace, Name: syncjob.Name})
+	var err error
+
+	// Get related mailbox resource
+	var mailbox mailcowv1.Mailbox
+	if err := r.Get(ctx, types.NamespacedName{Name: syncjob.Spec.Mailbox, Namespace: syncjob.Namespace}, &mailbox); err != nil {
+		// On deletion, e.g. during a namespace teardown, a mailbox that is gone leaves nothing to clean up
+		if !syncjob.ObjectMeta.DeletionTimestamp.IsZero() && errors.IsNotFound(err) {
+			log.Info("leaving syncjob untouched, its mailbox no longer exists")
+			return nil
+		}
+		log.Error(err, "unable to find related mailbox resource", "mailbox", syncjob.Spec.Mailbox)
+		return err
+	}
+
+	// Get related mailcow resource
+	res, err := mailcowv1.GetMailcow(ctx, r, syncjob.Namespace, mailbox.GetMailcowRef())
+	if err != nil {
+		if !syncjob.ObjectMeta.DeletionTimestamp.IsZero() && errors.IsNotFound(err) {
+			log.Info("leaving syncjob untouched, its mailcow no longer exists")
+			return nil
+		}
+		log.Error(err, "unable to find related mailcow resource", "mailcow", mailbox.GetMailcowRef().String())
+		return err
+	}
+
+	// The mailbox may be in a domain of another namespace, e.g. with the webhooks disabled, its sync jobs are never
+	// touched in mailcow, also not on deletion
+	if err := checkDomainOwnership(ctx, r, syncjob.Namespace, mailbox.GetMailcowRef(), mailbox.Spec.Domain); err != nil {
+		if !syncjob.ObjectMeta.DeletionTimestamp.IsZero() && isDomainNotAllowed(err) {
+			log.Info("leaving syncjob of a mailbox in a domain not owned by the namespace untouched in mailcow")
+			return nil
+		}
+		log.Error(err, "unable to use the domain of the mailbox")
+		return err
+	}
+
+	// Create mailcow client
+	client, err := r.Clients.Get(ctx, r, res)
+	if err != nil {
+		log.Error(err, "unable to create mailcow client")
+		return err
+	}
+
+	email := mailbox.Spec.LocalPart + "@" + mailbox.Spec.Domain
+
+	job, err := r.findSyncJob(ctx, client, syncjob, email)
+	if err != nil {
+		log.Error(err, "unable to get syncjobs")
+		return err
+	}
+
+	// A sync job found by its mailbox, host and user was not created by the resource, it is only managed when adopted
+	if job != nil && !helpers.IntPtrEqual(job.Id, syncjob.Status.ID) && syncjob.Annotations[constants.AnnotationAdopt] != "true" {
+		if !syncjob.ObjectMeta.DeletionTimestamp.IsZero() {
+			log.Info("leaving syncjob not created by the resource untouched in mailcow")
+			return nil
+		}
+		return &notAdoptedError{Object: fmt.Sprintf("sync job from %s for %s", syncjob.Spec.Host1, email)}
+	}
+
+	if !syncjob.ObjectMeta.DeletionTimestamp.IsZero() {
+		// Handle deletion
+		if job != nil {
+			_, err = client.DeleteSyncJobWithResponse(ctx, mailcow.DeleteSyncJobJSONRequestBody{strconv.Itoa(*job.Id)})
+			if err != nil {
+				log.Error(err, "unable to delete syncjob")
+				return err
+			}
+			r.Recorder.Eventf(syncjob, corev1.EventTypeNormal, "Deleted", "Deleted sync job %d of %s from mailcow", *job.Id, email)
+		}
+		return nil
+	}
+
+	// Get password from secret
+	password, err := syncjob.GetPassword(ctx, r)
+	if err != nil {
+		log.Error(err, "unable to get password from secret")
+		return err
+	}
+
+	// Salt with the uid, so the hash in the status can't be compared across resources
+	passwordHash := helpers.Hash(string(syncjob.UID), password)
+
+	port := strconv.Itoa(int(syncjob.Spec.Port1))
+	if job == nil {
+		// SyncJob does not exist, create it
+		_, err = client.CreateSyncJobWithResponse(ctx, mailcow.CreateSyncJobJSONRequestBody{
+			Username:            &email,
+			Host1:               &syncjob.Spec.Host1,
+			Port1:               &port,
+			User1:               &syncjob.Spec.User1,
+			Password1:           &password,
+			Enc1:                &syncjob.Spec.Enc1,
+			MinsInterval:        helpers.Int64ToFloat32(&syncjob.Spec.MinsInterval),
+			Maxage:              helpers.Int64ToFloat32(&syncjob.Spec.MaxAge),
+			Maxbytespersecond:   helpers.Int64ToFloat32(&syncjob.Spec.MaxBytesPerSecond),
+			Timeout1:            helpers.Int64ToFloat32(&syncjob.Spec.Timeout1),
+			Timeout2:            helpers.Int64ToFloat32(&syncjob.Spec.Timeout2),
+			Subfolder2:          &syncjob.Spec.Subfolder2,
+			Exclude:             &syncjob.Spec.Exclude,
+			CustomParams:        &syncjob.Spec.CustomParams,
+			Delete2duplicates:   syncjob.Spec.Delete2Duplicates,
+			Delete1:             syncjob.Spec.Delete1,
+			Delete2:             syncjob.Spec.Delete2,
+			Automap:             syncjob.Spec.Automap,
+			Skipcrossduplicates: syncjob.Spec.SkipCrossDuplicates,
+			Subscribeall:        syncjob.Spec.SubscribeAll,
+			Active:              syncjob.Spec.Active,
+		})
+
+		if err != nil {
+			log.Error(err, "unable to create syncjob")
+			return err
+		}
+
+		// Mailcow doesn't return the id of the created sync job, look it up
+		job, err = r.findSyncJob(ctx, client, syncjob, email)
+		if err != nil {
+			log.Error(err, "unable to get created syncjob")
+			return err
+		}
+		if job == nil {
+			return fmt.Errorf("created sync job from %s for %s not found in mailcow", syncjob.Spec.Host1, email)
+		}
+		r.Recorder.Eventf(syncjob, corev1.EventTypeNormal, "Created", "Created sync job from %s for %s in mailcow", syncjob.Spec.Host1, email)
+	} else {
+		// SyncJob exists, compare it against the spec
+		passwordChanged := syncjob.Status.PasswordHash != passwordHash
+		var drifted []string
+		if helpers.StringDrifted(syncjob.Spec.Host1, job.Host1) {
+			drifted = append(drifted, "host1")
+		}
+		port1 := int64(syncjob.Spec.Port1)
+		if helpers.IntDrifted(&port1, job.Port1) {
+			drifted = append(drifted, "port1")
+		}
+		if helpers.StringDrifted(syncjob.Spec.User1, job.User1) {
+			drifted = append(drifted, "user1")
+		}
+		if helpers.StringDrifted(syncjob.Spec.Enc1, job.Enc1) {
+			drifted = append(drifted, "enc1")
+		}
+		if helpers.StringDrifted(strconv.FormatInt(syncjob.Spec.MinsInterval, 10), job.MinsInterval) {
+			drifted = append(drifted, "minsInterval")
+		}
+		if helpers.IntDrifted(&syncjob.Spec.MaxAge, job.Maxage) {
+			drifted = append(drifted, "maxAge")
+		}
+		if helpers.StringDrifted(strconv.FormatInt(syncjob.Spec.MaxBytesPerSecond, 10), job.Maxbytespersecond) {
+			drifted = append(drifted, "maxBytesPerSecond")
+		}
+		if helpers.IntDrifted(&syncjob.Spec.Timeout1, job.Timeout1) {
+			drifted = append(drifted, "timeout1")
+		}
+		if helpers.IntDrifted(&syncjob.Spec.Timeout2, job.Timeout2) {
+			drifted = append(drifted, "timeout2")
+		}
+		if helpers.StringDrifted(syncjob.Spec.Subfolder2, job.Subfolder2) {
+			drifted = append(drifted, "subfolder2")
+		}
+		if helpers.StringDrifted(syncjob.Spec.Exclude, job.Exclude) {
+			drifted = append(drifted, "exclude")
+		}
+		if helpers.StringDrifted(syncjob.Spec.CustomParams, job.CustomParams) {
+			drifted = append(drifted, "customParams")
+		}
+		if helpers.BoolDrifted(syncjob.Spec.Delete2Duplicates, job.Delete2duplicates) {
+			drifted = append(drifted, "delete2Duplicates")
+		}
+		if helpers.BoolDrifted(syncjob.Spec.Delete1, job.Delete1) {
+			drifted = append(drifted, "delete1")
+		}
+		if helpers.BoolDrifted(syncjob.Spec.Delete2, job.Delete2) {
+			drifted = append(drifted, "delete2")
+		}
+		if helpers.BoolDrifted(syncjob.Spec.Automap, job.Automap) {
+			drifted = append(drifted, "automap")
+		}
+		if helpers.BoolDrifted(syncjob.Spec.SkipCrossDuplicates, job.Skipcrossduplicates) {
+			drifted = append(drifted, "skipCrossDuplicates")
+		}
+		if helpers.BoolDrifted(syncjob.Spec.SubscribeAll, job.Subscribeall) {
+			drifted = append(drifted, "subscribeAll")
+		}
+		if helpers.BoolStringDrifted(syncjob.Spec.Active, job.Active) {
+			drifted = append(drifted, "active")
+		}
+
+		if err := recordDrift(ctx, r.Client, r.Recorder, syncjob, &syncjob.Status.Conditions, drifted); err != nil {
+			log.Error(err, "unable to record drift")
+			return err
+		}
+
+		if len(drifted) > 0 || passwordChanged || !helpers.IsReconciled(syncjob.Status.Conditions, syncjob.Generation) {
+			// Sync job drifted, the secret or the spec changed, update it
+			enc1 := mailcow.EditSyncJobAttrEnc1(syncjob.Spec.Enc1)
+			attr := mailcow.EditSyncJobAttr{
+				Host1:               &syncjob.Spec.Host1,
+				Port1:               &port,
+				User1:               &syncjob.Spec.User1,
+				Enc1:                &enc1,
+				MinsInterval:        helpers.Int64ToFloat32(&syncjob.Spec.MinsInterval),
+				Maxage:              helpers.Int64ToFloat32(&syncjob.Spec.MaxAge),
+				Maxbytespersecond:   helpers.Int64ToFloat32(&syncjob.Spec.MaxBytesPerSecond),
+				Timeout1:            helpers.Int64ToFloat32(&syncjob.Spec.Timeout1),
+				Timeout2:            helpers.Int64ToFloat32(&syncjob.Spec.Timeout2),
+				Subfolder2:          &syncjob.Spec.Subfolder2,
+				Exclude:             &syncjob.Spec.Exclude,
+				CustomParams:        &syncjob.Spec.CustomParams,
+				Delete2duplicates:   syncjob.Spec.Delete2Duplicates,
+				Delete1:             syncjob.Spec.Delete1,
+				Delete2:             syncjob.Spec.Delete2,
+				Automap:             syncjob.Spec.Automap,
+				Skipcrossduplicates: syncjob.Spec.SkipCrossDuplicates,
+				Subscribeall:        syncjob.Spec.SubscribeAll,
+				Active:              syncjob.Spec.Active,
+			}
+			// Only push the password when the secret changed
+			if passwordChanged {
+				log.Info("password changed, updating syncjob password")
+				attr.Password1 = &password
+			}
+			_, err = client.UpdateSyncJobWithResponse(ctx, mailcow.UpdateSyncJobJSONRequestBody{
+				Attr:  &attr,
+				Items: &[]string{strconv.Itoa(*job.Id)},
+			})
+
+			if err != nil {
+				log.Error(err, "unable to update syncjob")
+				return err
+			}
+			r.Recorder.Eventf(syncjob, corev1.EventTypeNormal, "Updated", "Updated sync job %d of %s in mailcow", *job.Id, email)
+		}
+	}
+
+	// Update status with the last run of the sync job
+	if job != nil {
+		status := syncjob.Status.DeepCopy()
+		status.ID = job.Id
+		status.PasswordHash = passwordHash
+		status.Running = job.IsRunning != nil && *job.IsRunning == 1
+		if job.LastRun != nil {
+			status.LastRun = *job.LastRun
+		}
+		if job.ExitStatus != nil {
+			status.ExitStatus = *job.ExitStatus
+		}
+		if job.Success != nil {
+			success := *job.Success == 1
+			status.Success = &success
+		}
+		if !equality.Semantic.DeepEqual(status, &syncjob.Status) {
+			syncjob.Status = *status
+			if err := r.Status().Update(ctx, syncjob); err != nil {
+				log.Error(err, "unable to update syncjob status")
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// findSyncJob returns the mailcow sync job of this resource, or nil if it doesn't exist.
+// The id in the status is used when known, otherwise or when that job is gone the job is matched on mailbox, host
+// and user. Such a match is only managed when the resource just created it or adopts it.
+func (r *SyncJobReconciler) findSyncJob(ctx context.Context, client *mailcow.ClientWithResponses, syncjob *mailcowv1.SyncJob, email string) (*mailcow.SyncJob, error) {
+	jobs, err := client.ListSyncJobs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if syncjob.Status.ID != nil {
+		for i, job := range jobs {
+			if job.Id != nil && *job.Id == *syncjob.Status.ID {
+				return &jobs[i], nil
+			}
+		}
+	}
+
+	for i, job := range jobs {
+		if job.Id != nil && job.User2 != nil && *job.User2 == email &&
+			job.Host1 != nil && *job.Host1 == syncjob.Spec.Host1 &&
+			job.User1 != nil && *job.User1 == syncjob.Spec.User1 {
+			return &jobs[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *SyncJobReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &mailcowv1.SyncJob{}, passwordSecretIndex, func(obj client.Object) []string {
+		return []string{obj.(*mailcowv1.SyncJob).Spec.PasswordSecret.Name}
+	}); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&mailcowv1.SyncJob{}).
+		Watches(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(r.findSyncJobsForSecret)).
+		Named("syncjob").
+		Complete(r)
+}
+
+// findSyncJobsForSecret returns a request for every sync job that uses the secret as password secret.
+func (r *SyncJobReconciler) findSyncJobsForSecret(ctx context.Context, secret client.Object) []reconcile.Request {
+	var syncjobs mailcowv1.SyncJobList
+	if err := r.List(ctx, &syncjobs, client.InNamespace(secret.GetNamespace()), client.MatchingFields{passwordSecretIndex: secret.GetName()}); err != nil {
+		log.FromContext(ctx).Error(err, "unable to list syncjobs for secret", "secret", secret.GetName())
+		return nil
+	}
+
+	requests := make([]reconcile.Request, len(syncjobs.Items))
+	for i, syncjob := range syncjobs.Items {
+		requests[i] = reconcile.Request{NamespacedName: types.NamespacedName{Name: syncjob.Name, Namespace: syncjob.Namespace}}
+	}
+	return requests
+}
+
+func (r *SyncJobReconciler) setProgressing(ctx context.Context, syncjob *mailcowv1.SyncJob, message string) (bool, error) {
+	changed := helpers.SetConditionStatus(&syncjob.Status.Conditions, constants.ConditionProgressing, "Reconciling", message, syncjob.Generation)
+	if !changed {
+		return changed, nil
+	}
+	syncjob.Status.Phase = constants.ConditionProgressing
+	return changed, r.Status().Update(ctx, syncjob)
+}
+
+func (r *SyncJobReconciler) setReady(ctx context.Context, syncjob *mailcowv1.SyncJob, message string) (bool, error) {
+	changed := helpers.SetConditionStatus(&syncjob.Status.Conditions, constants.ConditionReady, "Reconciled", message, syncjob.Generation)
+	if !changed {
+		return changed, nil
+	}
+	syncjob.Status.Phase = constants.ConditionReady
+	return changed, r.Status().Update(ctx, syncjob)
+}
+
+func (r *SyncJobReconciler) setDegraded(ctx context.Context, syncjob *mailcowv1.SyncJob, reason, message string) (bool, error) {
+	changed := helpers.SetConditionStatus(&syncjob.Status.Conditions, constants.ConditionDegraded, reason, message, syncjob.Generation)
+	if !changed {
+		return changed, nil
+	}
+	syncjob.Status.Phase = constants.ConditionDegraded
+	return changed, r.Status().Update(ctx, syncjob)
+}
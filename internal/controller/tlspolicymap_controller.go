@@ -0,0 +1,356 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	mailcowv1 "github.com/tarteo/mailcow-operator/api/v1"
+	constants "github.com/tarteo/mailcow-operator/common"
+	helpers "github.com/tarteo/mailcow-operator/helpers"
+	"github.com/tarteo/mailcow-operator/mailcow"
+)
+
+// TLSPolicyMapReconciler reconciles a TLSPolicyMap object
+type TLSPolicyMapReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Clients  *MailcowClients
+	Recorder record.EventRecorder
+}
+
+// +kubebuilder:rbac:groups=mailcow.onestein.nl,resources=tlspolicymaps,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=mailcow.onestein.nl,resources=tlspolicymaps/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=mailcow.onestein.nl,resources=tlspolicymaps/finalizers,verbs=update
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+// Reconcile creates, updates and deletes the TLS policy map in mailcow.
+//
+// For more details, check Reconcile and its Result here:
+// - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.19.1/pkg/reconcile
+func (r *TLSPolicyMapReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := log.FromContext(ctx).WithValues("namespace", req.NamespacedName)
+	log.Info("reconciling tlspolicymap")
+
+	var tlsPolicyMap mailcowv1.TLSPolicyMap
+	if err := r.Get(ctx, req.NamespacedName, &tlsPolicyMap); err != nil {
+		if errors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		log.Error(err, "unable to find tlspolicymap")
+		return ctrl.Result{}, err
+	}
+
+	// Apply finalizer
+	if tlsPolicyMap.ObjectMeta.DeletionTimestamp.IsZero() {
+		if !controllerutil.ContainsFinalizer(&tlsPolicyMap, constants.Finalizer) {
+			controllerutil.AddFinalizer(&tlsPolicyMap, constants.Finalizer)
+			if err := r.Update(ctx, &tlsPolicyMap); err != nil {
+				log.Error(err, "unable to update tlspolicymap with finalizer")
+				return ctrl.Result{}, err
+			}
+
+			// Return and requeue to get fresh object
+			return ctrl.Result{Requeue: true}, nil
+		}
+		// Set progressing status
+		if changed, err := r.setProgressing(ctx, &tlsPolicyMap, "Reconciling TLS policy map"); err != nil {
+			log.Error(err, "unable to set progressing status")
+			return ctrl.Result{}, err
+		} else if changed {
+			// Requeue to get fresh object with updated status
+			return ctrl.Result{Requeue: true}, nil
+		}
+	}
+
+	// Reconcile the resource
+	if err := r.ReconcileResource(ctx, &tlsPolicyMap); err != nil {
+		log.Error(err, "unable to reconcile mailcow tlspolicymap")
+		// Set degraded status
+		changed, errStatus := r.setDegraded(ctx, &tlsPolicyMap, errorReason(err, "ReconcileFailed"), err.Error())
+		if errStatus != nil {
+			log.Error(errStatus, "unable to set degraded status")
+			return ctrl.Result{}, errStatus
+		}
+		// Only record the error when it changed, so requeues don't repeat the same event
+		if changed {
+			recordError(r.Recorder, &tlsPolicyMap, err)
+		}
+		return handleReconcileError(ctx, r.Client, tlsPolicyMap.Namespace, tlsPolicyMap.GetMailcowRef(), err)
+	}
+
+	// Remove finalizer if deletion timestamp is set
+	if !tlsPolicyMap.ObjectMeta.DeletionTimestamp.IsZero() && controllerutil.ContainsFinalizer(&tlsPolicyMap, constants.Finalizer) {
+		controllerutil.RemoveFinalizer(&tlsPolicyMap, constants.Finalizer)
+		if err := r.Update(ctx, &tlsPolicyMap); err != nil {
+			log.Error(err, "unable to update tlspolicymap with finalizer")
+			return ctrl.Result{}, err
+		}
+
+		return ctrl.Result{}, nil
+	}
+
+	// Set ready status
+	if _, err := r.setReady(ctx, &tlsPolicyMap, "TLS policy map successfully reconciled"); err != nil {
+		log.Error(err, "unable to set ready status")
+		return ctrl.Result{}, err
+	}
+
+	// Requeue to detect drift in mailcow
+	return ctrl.Result{RequeueAfter: resyncInterval(ctx, r, tlsPolicyMap.Namespace, tlsPolicyMap.GetMailcowRef(), tlsPolicyMap.Spec.ResyncInterval)}, nil
+}
+
+func (r *TLSPolicyMapReconciler) ReconcileResource(ctx context.Context, tlsPolicyMap *mailcowv1.TLSPolicyMap) error {
+	log := log.FromContext(ctx).WithValues("namespace", types.NamespacedName{Namespace: tlsPolicyMap.Namespace, Name: tlsPolicyMap.Name})
+	var err error
+
+	// A tlspolicymap retained by its own deletionPolicy needs no mailcow, so it can be deleted after its mailcow
+	if !tlsPolicyMap.ObjectMeta.DeletionTimestamp.IsZero() && tlsPolicyMap.Spec.DeletionPolicy == mailcowv1.DeletionPolicyRetain {
+		log.Info("retaining tlspolicymap in mailcow")
+		return nil
+	}
+
+	// Get related mailcow resource
+	res, err := mailcowv1.GetMailcow(ctx, r, tlsPolicyMap.Namespace, tlsPolicyMap.GetMailcowRef())
+	if err != nil {
+		// On deletion, e.g. during a namespace teardown, a mailcow that is gone leaves nothing to clean up
+		if !tlsPolicyMap.ObjectMeta.DeletionTimestamp.IsZero() && errors.IsNotFound(err) {
+			log.Info("leaving tlspolicymap untouched, its mailcow no longer exists")
+			return nil
+		}
+		log.Error(err, "unable to find related mailcow resource", "mailcow", tlsPolicyMap.GetMailcowRef().String())
+		return err
+	}
+
+	deleting := !tlsPolicyMap.ObjectMeta.DeletionTimestamp.IsZero()
+	dest := tlsPolicyMap.Spec.Dest
+
+	// The policy of a destination weakens the TLS of all mail sent to it, so it must be a domain of the namespace
+	if err := checkDomainOwnership(ctx, r, tlsPolicyMap.Namespace, tlsPolicyMap.GetMailcowRef(), localDestDomain(dest)); err != nil {
+		if deleting && isDomainNotAllowed(err) {
+			log.Info("leaving tlspolicymap of a domain not owned by the namespace untouched in mailcow")
+			return nil
+		}
+		log.Error(err, "unable to use the domain of the tlspolicymap")
+		return err
+	}
+
+	// Retain leaves the tlspolicymap in mailcow untouched on deletion
+	deletionPolicy := res.GetDeletionPolicy(tlsPolicyMap.Spec.DeletionPolicy)
+	if deleting && deletionPolicy == mailcowv1.DeletionPolicyRetain {
+		log.Info("retaining tlspolicymap in mailcow")
+		return nil
+	}
+
+	// Reconcile mailcow tlspolicymap
+	client, err := r.Clients.Get(ctx, r, res)
+	if err != nil {
+		log.Error(err, "unable to create mailcow client")
+		return err
+	}
+
+	live, err := r.findTLSPolicyMap(ctx, client, tlsPolicyMap)
+	if err != nil {
+		log.Error(err, "unable to get tlspolicymaps")
+		return err
+	}
+
+	// A TLS policy map found by its destination was not created by the resource, it is only managed when adopted
+	if live != nil && tlsPolicyMap.Status.ID == nil && tlsPolicyMap.Annotations[constants.AnnotationAdopt] != "true" {
+		if deleting {
+			log.Info("leaving tlspolicymap not created by the resource untouched in mailcow")
+			return nil
+		}
+		return &notAdoptedError{Object: "TLS policy map of " + dest}
+	}
+
+	if deleting {
+		// Handle deletion
+		if live != nil && deletionPolicy == mailcowv1.DeletionPolicyDisable {
+			active := false
+			_, err = client.UpdateTLSPolicyMapWithResponse(ctx, mailcow.UpdateTLSPolicyMapJSONRequestBody{
+				Attr:  &mailcow.EditTLSPolicyMapAttr{Active: &active},
+				Items: &[]string{strconv.Itoa(*live.Id)},
+			})
+			if err != nil {
+				log.Error(err, "unable to disable tlspolicymap")
+				return err
+			}
+			r.Recorder.Eventf(tlsPolicyMap, corev1.EventTypeNormal, "Disabled", "Disabled TLS policy map of %s in mailcow", dest)
+		} else if live != nil {
+			_, err = client.DeleteTLSPolicyMapWithResponse(ctx, mailcow.DeleteTLSPolicyMapJSONRequestBody{strconv.Itoa(*live.Id)})
+			if err != nil {
+				log.Error(err, "unable to delete tlspolicymap")
+				return err
+			}
+			r.Recorder.Eventf(tlsPolicyMap, corev1.EventTypeNormal, "Deleted", "Deleted TLS policy map of %s from mailcow", dest)
+		}
+		return nil
+	}
+
+	active := tlsPolicyMap.Spec.Active == nil || *tlsPolicyMap.Spec.Active
+	policy := string(tlsPolicyMap.Spec.Policy)
+
+	if live == nil {
+		// Adopted tlspolicymaps are never recreated
+		if tlsPolicyMap.Annotations[constants.AnnotationAdopt] == "true" {
+			return fmt.Errorf("adopted TLS policy map of %s does not exist in mailcow", dest)
+		}
+
+		// TLS policy map does not exist, create it
+		_, err = client.CreateTLSPolicyMapWithResponse(ctx, mailcow.CreateTLSPolicyMapJSONRequestBody{
+			Active:     helpers.BoolToFloat32(&active),
+			Dest:       &dest,
+			Parameters: &tlsPolicyMap.Spec.Parameters,
+			Policy:     (*mailcow.CreateTLSPolicyMapJSONBodyPolicy)(&policy),
+		})
+		if err != nil {
+			log.Error(err, "unable to create tlspolicymap")
+			return err
+		}
+
+		// Mailcow doesn't return the id of the created TLS policy map, look it up
+		live, err = r.findTLSPolicyMap(ctx, client, tlsPolicyMap)
+		if err != nil {
+			log.Error(err, "unable to get created tlspolicymap")
+			return err
+		}
+		r.Recorder.Eventf(tlsPolicyMap, corev1.EventTypeNormal, "Created", "Created TLS policy map of %s in mailcow", dest)
+	} else {
+		// TLS policy map exists, compare it against the spec
+		var drifted []string
+		if liveActive := live.Active.String(); liveActive != "" && helpers.BoolStringDrifted(&active, &liveActive) {
+			drifted = append(drifted, "active")
+		}
+		if live.Policy != nil && *live.Policy != string(tlsPolicyMap.Spec.Policy) {
+			drifted = append(drifted, "policy")
+		}
+		if live.Parameters != nil && strings.TrimSpace(*live.Parameters) != strings.TrimSpace(tlsPolicyMap.Spec.Parameters) {
+			drifted = append(drifted, "parameters")
+		}
+
+		if err := recordDrift(ctx, r.Client, r.Recorder, tlsPolicyMap, &tlsPolicyMap.Status.Conditions, drifted); err != nil {
+			log.Error(err, "unable to record drift")
+			return err
+		}
+
+		if len(drifted) > 0 || !helpers.IsReconciled(tlsPolicyMap.Status.Conditions, tlsPolicyMap.Generation) {
+			// TLS policy map drifted or the spec changed, update it
+			_, err = client.UpdateTLSPolicyMapWithResponse(ctx, mailcow.UpdateTLSPolicyMapJSONRequestBody{
+				Attr: &mailcow.EditTLSPolicyMapAttr{
+					Active:     &active,
+					Dest:       &dest,
+					Parameters: &tlsPolicyMap.Spec.Parameters,
+					Policy:     &policy,
+				},
+				Items: &[]string{strconv.Itoa(*live.Id)},
+			})
+			if err != nil {
+				log.Error(err, "unable to update tlspolicymap")
+				return err
+			}
+			r.Recorder.Eventf(tlsPolicyMap, corev1.EventTypeNormal, "Updated", "Updated TLS policy map of %s in mailcow", dest)
+		}
+	}
+
+	var id *int
+	if live != nil {
+		id = live.Id
+	}
+	if !helpers.IntPtrEqual(tlsPolicyMap.Status.ID, id) {
+		tlsPolicyMap.Status.ID = id
+		if err := r.Status().Update(ctx, tlsPolicyMap); err != nil {
+			log.Error(err, "unable to update tlspolicymap status")
+			return err
+		}
+	}
+
+	return nil
+}
+
+// findTLSPolicyMap returns the TLS policy map in mailcow by the id in the status, or by its destination.
+func (r *TLSPolicyMapReconciler) findTLSPolicyMap(ctx context.Context, client *mailcow.ClientWithResponses, tlsPolicyMap *mailcowv1.TLSPolicyMap) (*mailcow.TLSPolicyMap, error) {
+	maps, err := client.ListTLSPolicyMaps(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, m := range maps {
+		if m.Id == nil {
+			continue
+		}
+		if tlsPolicyMap.Status.ID != nil {
+			if *m.Id == *tlsPolicyMap.Status.ID {
+				return &maps[i], nil
+			}
+			continue
+		}
+		if m.Dest != nil && strings.EqualFold(*m.Dest, tlsPolicyMap.Spec.Dest) {
+			return &maps[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *TLSPolicyMapReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&mailcowv1.TLSPolicyMap{}).
+		Named("tlspolicymap").
+		Complete(r)
+}
+
+func (r *TLSPolicyMapReconciler) setProgressing(ctx context.Context, tlsPolicyMap *mailcowv1.TLSPolicyMap, message string) (bool, error) {
+	changed := helpers.SetConditionStatus(&tlsPolicyMap.Status.Conditions, constants.ConditionProgressing, "Reconciling", message, tlsPolicyMap.Generation)
+	if !changed {
+		return changed, nil
+	}
+	tlsPolicyMap.Status.Phase = constants.ConditionProgressing
+	return changed, r.Status().Update(ctx, tlsPolicyMap)
+}
+
+func (r *TLSPolicyMapReconciler) setReady(ctx context.Context, tlsPolicyMap *mailcowv1.TLSPolicyMap, message string) (bool, error) {
+	changed := helpers.SetConditionStatus(&tlsPolicyMap.Status.Conditions, constants.ConditionReady, "Reconciled", message, tlsPolicyMap.Generation)
+	if !changed {
+		return changed, nil
+	}
+	tlsPolicyMap.Status.Phase = constants.ConditionReady
+	return changed, r.Status().Update(ctx, tlsPolicyMap)
+}
+
+func (r *TLSPolicyMapReconciler) setDegraded(ctx context.Context, tlsPolicyMap *mailcowv1.TLSPolicyMap, reason, message string) (bool, error) {
+	changed := helpers.SetConditionStatus(&tlsPolicyMap.Status.Conditions, constants.ConditionDegraded, reason, message, tlsPolicyMap.Generation)
+	if !changed {
+		return changed, nil
+	}
+	tlsPolicyMap.Status.Phase = constants.ConditionDegraded
+	return changed, r.Status().Update(ctx, tlsPolicyMap)
+}
@@ -0,0 +1,402 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	mailcowv1 "github.com/tarteo/mailcow-operator/api/v1"
+	constants "github.com/tarteo/mailcow-operator/common"
+	helpers "github.com/tarteo/mailcow-operator/helpers"
+	"github.com/tarteo/mailcow-operator/mailcow"
+)
+
+// TransportMapReconciler reconciles a RecipientMap object
+type TransportMapReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Clients  *MailcowClients
+	Recorder record.EventRecorder
+}
+
+// +kubebuilder:rbac:groups=mailcow.onestein.nl,resources=transportmaps,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=mailcow.onestein.nl,resources=transportmaps/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=mailcow.onestein.nl,resources=transportmaps/finalizers,verbs=update
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+// Reconcile creates, updates and deletes the recipient map in mailcow.
+//
+// For more details, check Reconcile and its Result here:
+// - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.19.1/pkg/reconcile
+func (r *TransportMapReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := log.FromContext(ctx).WithValues("namespace", req.NamespacedName)
+	log.Info("reconciling transportmap")
+
+	var transportMap mailcowv1.TransportMap
+	if err := r.Get(ctx, req.NamespacedName, &transportMap); err != nil {
+		if errors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		log.Error(err, "unable to find transportmap")
+		return ctrl.Result{}, err
+	}
+
+	// Apply finalizer
+	if transportMap.ObjectMeta.DeletionTimestamp.IsZero() {
+		if !controllerutil.ContainsFinalizer(&transportMap, constants.Finalizer) {
+			controllerutil.AddFinalizer(&transportMap, constants.Finalizer)
+			if err := r.Update(ctx, &transportMap); err != nil {
+				log.Error(err, "unable to update transportmap with finalizer")
+				return ctrl.Result{}, err
+			}
+
+			// Return and requeue to get fresh object
+			return ctrl.Result{Requeue: true}, nil
+		}
+		// Set progressing status
+		if changed, err := r.setProgressing(ctx, &transportMap, "Reconciling transport map"); err != nil {
+			log.Error(err, "unable to set progressing status")
+			return ctrl.Result{}, err
+		} else if changed {
+			// Requeue to get fresh object with updated status
+			return ctrl.Result{Requeue: true}, nil
+		}
+	}
+
+	// Reconcile the resource
+	if err := r.ReconcileResource(ctx, &transportMap); err != nil {
+		log.Error(err, "unable to reconcile mailcow transportmap")
+		// Set degraded status
+		changed, errStatus := r.setDegraded(ctx, &transportMap, errorReason(err, "ReconcileFailed"), err.Error())
+		if errStatus != nil {
+			log.Error(errStatus, "unable to set degraded status")
+			return ctrl.Result{}, errStatus
+		}
+		// Only record the error when it changed, so requeues don't repeat the same event
+		if changed {
+			recordError(r.Recorder, &transportMap, err)
+		}
+		return handleReconcileError(ctx, r.Client, transportMap.Namespace, transportMap.GetMailcowRef(), err)
+	}
+
+	// Remove finalizer if deletion timestamp is set
+	if !transportMap.ObjectMeta.DeletionTimestamp.IsZero() && controllerutil.ContainsFinalizer(&transportMap, constants.Finalizer) {
+		controllerutil.RemoveFinalizer(&transportMap, constants.Finalizer)
+		if err := r.Update(ctx, &transportMap); err != nil {
+			log.Error(err, "unable to update transportmap with finalizer")
+			return ctrl.Result{}, err
+		}
+
+		return ctrl.Result{}, nil
+	}
+
+	// Set ready status
+	if _, err := r.setReady(ctx, &transportMap, "Transport map successfully reconciled"); err != nil {
+		log.Error(err, "unable to set ready status")
+		return ctrl.Result{}, err
+	}
+
+	// Requeue to detect drift in mailcow
+	return ctrl.Result{RequeueAfter: resyncInterval(ctx, r, transportMap.Namespace, transportMap.GetMailcowRef(), transportMap.Spec.ResyncInterval)}, nil
+}
+
+func (r *TransportMapReconciler) ReconcileResource(ctx context.Context, transportMap *mailcowv1.TransportMap) error {
+	log := log.FromContext(ctx).WithValues("namespace", types.NamespacedName{Namespace: transportMap.Namespace, Name: transportMap.Name})
+	var err error
+
+	// A transportmap retained by its own deletionPolicy needs no mailcow, so it can be deleted after its mailcow
+	if !transportMap.ObjectMeta.DeletionTimestamp.IsZero() && transportMap.Spec.DeletionPolicy == mailcowv1.DeletionPolicyRetain {
+		log.Info("retaining transportmap in mailcow")
+		return nil
+	}
+
+	// Get related mailcow resource
+	res, err := mailcowv1.GetMailcow(ctx, r, transportMap.Namespace, transportMap.GetMailcowRef())
+	if err != nil {
+		// On deletion, e.g. during a namespace teardown, a mailcow that is gone leaves nothing to clean up
+		if !transportMap.ObjectMeta.DeletionTimestamp.IsZero() && errors.IsNotFound(err) {
+			log.Info("leaving transportmap untouched, its mailcow no longer exists")
+			return nil
+		}
+		log.Error(err, "unable to find related mailcow resource", "mailcow", transportMap.GetMailcowRef().String())
+		return err
+	}
+
+	deleting := !transportMap.ObjectMeta.DeletionTimestamp.IsZero()
+	destination := transportMap.Spec.Destination
+
+	// Transports apply to local domains too, so the destination must be a domain of the namespace, also on deletion
+	if err := checkDomainOwnership(ctx, r, transportMap.Namespace, transportMap.GetMailcowRef(), localDestDomain(destination)); err != nil {
+		if deleting && isDomainNotAllowed(err) {
+			log.Info("leaving transportmap of a domain not owned by the namespace untouched in mailcow")
+			return nil
+		}
+		log.Error(err, "unable to use the domain of the transportmap")
+		return err
+	}
+
+	// Retain leaves the transportmap in mailcow untouched on deletion
+	deletionPolicy := res.GetDeletionPolicy(transportMap.Spec.DeletionPolicy)
+	if deleting && deletionPolicy == mailcowv1.DeletionPolicyRetain {
+		log.Info("retaining transportmap in mailcow")
+		return nil
+	}
+
+	// Reconcile mailcow transportmap
+	client, err := r.Clients.Get(ctx, r, res)
+	if err != nil {
+		log.Error(err, "unable to create mailcow client")
+		return err
+	}
+
+	live, err := r.findTransport(ctx, client, transportMap)
+	if err != nil {
+		log.Error(err, "unable to get transportmaps")
+		return err
+	}
+
+	// A transport map found by its destination was not created by the resource, it is only managed when adopted
+	if live != nil && transportMap.Status.ID == nil && transportMap.Annotations[constants.AnnotationAdopt] != "true" {
+		if deleting {
+			log.Info("leaving transportmap not created by the resource untouched in mailcow")
+			return nil
+		}
+		return &notAdoptedError{Object: "transport map of " + destination}
+	}
+
+	if deleting {
+		// Handle deletion
+		if live != nil && deletionPolicy == mailcowv1.DeletionPolicyDisable {
+			active := false
+			_, err = client.UpdateTransportMapsWithResponse(ctx, mailcow.UpdateTransportMapsJSONRequestBody{
+				Attr:  &mailcow.EditTransportAttr{Active: &active},
+				Items: &[]string{strconv.Itoa(*live.Id)},
+			})
+			if err != nil {
+				log.Error(err, "unable to disable transportmap")
+				return err
+			}
+			r.Recorder.Eventf(transportMap, corev1.EventTypeNormal, "Disabled", "Disabled transport map of %s in mailcow", destination)
+		} else if live != nil {
+			_, err = client.DeleteTransportMapsWithResponse(ctx, mailcow.DeleteTransportMapsJSONRequestBody{strconv.Itoa(*live.Id)})
+			if err != nil {
+				log.Error(err, "unable to delete transportmap")
+				return err
+			}
+			r.Recorder.Eventf(transportMap, corev1.EventTypeNormal, "Deleted", "Deleted transport map of %s from mailcow", destination)
+		}
+		return nil
+	}
+
+	password, err := transportMap.GetPassword(ctx, r)
+	if err != nil {
+		log.Error(err, "unable to get password from secret")
+		return err
+	}
+
+	// Salt with the uid, so the hash in the status can't be compared across resources
+	passwordHash := helpers.Hash(string(transportMap.UID), password)
+	active := transportMap.Spec.Active == nil || *transportMap.Spec.Active
+
+	if live == nil {
+		// Adopted transportmaps are never recreated
+		if transportMap.Annotations[constants.AnnotationAdopt] == "true" {
+			return fmt.Errorf("adopted transport map of %s does not exist in mailcow", destination)
+		}
+
+		// Transport map does not exist, create it
+		_, err = client.CreateTransportMapsWithResponse(ctx, mailcow.CreateTransportMapsJSONRequestBody{
+			Active:      helpers.BoolToFloat32(&active),
+			Destination: &destination,
+			Nexthop:     &transportMap.Spec.Nexthop,
+			Username:    &transportMap.Spec.Username,
+			Password:    &password,
+		})
+		if err != nil {
+			log.Error(err, "unable to create transportmap")
+			return err
+		}
+
+		// Mailcow doesn't return the id of the created transport map, look it up
+		live, err = r.findTransport(ctx, client, transportMap)
+		if err != nil {
+			log.Error(err, "unable to get created transportmap")
+			return err
+		}
+		if live == nil {
+			return fmt.Errorf("created transport map of %s not found in mailcow", destination)
+		}
+		r.Recorder.Eventf(transportMap, corev1.EventTypeNormal, "Created", "Created transport map of %s in mailcow", destination)
+	} else {
+		// Transport map exists, compare it against the spec
+		passwordChanged := transportMap.Status.PasswordHash != passwordHash
+		var drifted []string
+		if liveActive := live.Active.String(); liveActive != "" && helpers.BoolStringDrifted(&active, &liveActive) {
+			drifted = append(drifted, "active")
+		}
+		if helpers.StringDrifted(transportMap.Spec.Nexthop, live.Nexthop) {
+			drifted = append(drifted, "nexthop")
+		}
+		if helpers.StringDrifted(transportMap.Spec.Username, live.Username) {
+			drifted = append(drifted, "username")
+		}
+		// A rotated secret is not drift, the new password is pushed below
+		if !passwordChanged && helpers.StringDrifted(password, live.Password) {
+			drifted = append(drifted, "password")
+		}
+
+		if err := recordDrift(ctx, r.Client, r.Recorder, transportMap, &transportMap.Status.Conditions, drifted); err != nil {
+			log.Error(err, "unable to record drift")
+			return err
+		}
+
+		if len(drifted) > 0 || passwordChanged || !helpers.IsReconciled(transportMap.Status.Conditions, transportMap.Generation) {
+			// Transport map drifted, the secret or the spec changed, update it
+			if passwordChanged {
+				log.Info("password changed, updating transportmap password")
+			}
+			_, err = client.UpdateTransportMapsWithResponse(ctx, mailcow.UpdateTransportMapsJSONRequestBody{
+				Attr: &mailcow.EditTransportAttr{
+					Active:      &active,
+					Destination: &destination,
+					Nexthop:     &transportMap.Spec.Nexthop,
+					Username:    &transportMap.Spec.Username,
+					Password:    &password,
+				},
+				Items: &[]string{strconv.Itoa(*live.Id)},
+			})
+			if err != nil {
+				log.Error(err, "unable to update transportmap")
+				return err
+			}
+			r.Recorder.Eventf(transportMap, corev1.EventTypeNormal, "Updated", "Updated transport map of %s in mailcow", destination)
+		}
+	}
+
+	if !helpers.IntPtrEqual(transportMap.Status.ID, live.Id) || transportMap.Status.PasswordHash != passwordHash {
+		transportMap.Status.ID = live.Id
+		transportMap.Status.PasswordHash = passwordHash
+		if err := r.Status().Update(ctx, transportMap); err != nil {
+			log.Error(err, "unable to update transportmap status")
+			return err
+		}
+	}
+
+	return nil
+}
+
+// findTransport returns the transport map in mailcow by the id in the status, or by its destination when the resource
+// has no id yet.
+func (r *TransportMapReconciler) findTransport(ctx context.Context, client *mailcow.ClientWithResponses, transportMap *mailcowv1.TransportMap) (*mailcow.Transport, error) {
+	transports, err := client.ListTransports(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, h := range transports {
+		if h.Id == nil {
+			continue
+		}
+		if transportMap.Status.ID != nil {
+			if *h.Id == *transportMap.Status.ID {
+				return &transports[i], nil
+			}
+			continue
+		}
+		if h.Destination != nil && strings.EqualFold(*h.Destination, transportMap.Spec.Destination) {
+			return &transports[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *TransportMapReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &mailcowv1.TransportMap{}, passwordSecretIndex, func(obj client.Object) []string {
+		if selector := obj.(*mailcowv1.TransportMap).Spec.PasswordSecret; selector != nil {
+			return []string{selector.Name}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&mailcowv1.TransportMap{}).
+		Watches(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(r.findTransportMapsForSecret)).
+		Named("transportmap").
+		Complete(r)
+}
+
+// findTransportMapsForSecret returns a request for every transport map that uses the secret as password secret.
+func (r *TransportMapReconciler) findTransportMapsForSecret(ctx context.Context, secret client.Object) []reconcile.Request {
+	var transportMaps mailcowv1.TransportMapList
+	if err := r.List(ctx, &transportMaps, client.InNamespace(secret.GetNamespace()), client.MatchingFields{passwordSecretIndex: secret.GetName()}); err != nil {
+		log.FromContext(ctx).Error(err, "unable to list transportmaps for secret", "secret", secret.GetName())
+		return nil
+	}
+
+	requests := make([]reconcile.Request, len(transportMaps.Items))
+	for i, transportMap := range transportMaps.Items {
+		requests[i] = reconcile.Request{NamespacedName: types.NamespacedName{Name: transportMap.Name, Namespace: transportMap.Namespace}}
+	}
+	return requests
+}
+
+func (r *TransportMapReconciler) setProgressing(ctx context.Context, transportMap *mailcowv1.TransportMap, message string) (bool, error) {
+	changed := helpers.SetConditionStatus(&transportMap.Status.Conditions, constants.ConditionProgressing, "Reconciling", message, transportMap.Generation)
+	if !changed {
+		return changed, nil
+	}
+	transportMap.Status.Phase = constants.ConditionProgressing
+	return changed, r.Status().Update(ctx, transportMap)
+}
+
+func (r *TransportMapReconciler) setReady(ctx context.Context, transportMap *mailcowv1.TransportMap, message string) (bool, error) {
+	changed := helpers.SetConditionStatus(&transportMap.Status.Conditions, constants.ConditionReady, "Reconciled", message, transportMap.Generation)
+	if !changed {
+		return changed, nil
+	}
+	transportMap.Status.Phase = constants.ConditionReady
+	return changed, r.Status().Update(ctx, transportMap)
+}
+
+func (r *TransportMapReconciler) setDegraded(ctx context.Context, transportMap *mailcowv1.TransportMap, reason, message string) (bool, error) {
+	changed := helpers.SetConditionStatus(&transportMap.Status.Conditions, constants.ConditionDegraded, reason, message, transportMap.Generation)
+	if !changed {
+		return changed, nil
+	}
+	transportMap.Status.Phase = constants.ConditionDegraded
+	return changed, r.Status().Update(ctx, transportMap)
+}
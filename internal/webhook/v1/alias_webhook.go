@@ -0,0 +1,159 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	mailcowv1 "github.com/tarteo/mailcow-operator/api/v1"
+	helpers "github.com/tarteo/mailcow-operator/helpers"
+)
+
+// aliasSpecialGoTo are the destinations mailcow accepts besides email addresses.
+var aliasSpecialGoTo = []string{"null@localhost", "spam@localhost", "ham@localhost"}
+
+// log is for logging in this package.
+var aliaslog = logf.Log.WithName("alias-resource")
+
+// SetupAliasWebhookWithManager registers the webhook for Alias in the manager.
+func SetupAliasWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&mailcowv1.Alias{}).
+		WithValidator(&AliasCustomValidator{Client: mgr.GetClient()}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-mailcow-onestein-nl-v1-alias,mutating=false,failurePolicy=fail,sideEffects=None,groups=mailcow.onestein.nl,resources=aliases,verbs=create;update,versions=v1,name=valias-v1.kb.io,admissionReviewVersions=v1
+
+// AliasCustomValidator struct is responsible for validating the Alias resource
+// when it is created, updated, or deleted.
+type AliasCustomValidator struct {
+	Client client.Reader
+}
+
+var _ webhook.CustomValidator = &AliasCustomValidator{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type Alias.
+func (v *AliasCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	alias, ok := obj.(*mailcowv1.Alias)
+	if !ok {
+		return nil, fmt.Errorf("expected an Alias object but got %T", obj)
+	}
+	aliaslog.Info("Validation for Alias upon creation", "name", alias.GetName())
+
+	return v.validateAlias(ctx, alias)
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type Alias.
+func (v *AliasCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	alias, ok := newObj.(*mailcowv1.Alias)
+	if !ok {
+		return nil, fmt.Errorf("expected an Alias object for the newObj but got %T", newObj)
+	}
+	oldAlias, ok := oldObj.(*mailcowv1.Alias)
+	if !ok {
+		return nil, fmt.Errorf("expected an Alias object for the oldObj but got %T", oldObj)
+	}
+	aliaslog.Info("Validation for Alias upon update", "name", alias.GetName())
+
+	// Metadata only changes, e.g. finalizers, are always allowed
+	if equality.Semantic.DeepEqual(oldAlias.Spec, alias.Spec) {
+		return nil, nil
+	}
+
+	return v.validateAlias(ctx, alias)
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type Alias.
+func (v *AliasCustomValidator) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *AliasCustomValidator) validateAlias(ctx context.Context, alias *mailcowv1.Alias) (admission.Warnings, error) {
+	var allErrs field.ErrorList
+	var warnings admission.Warnings
+	specPath := field.NewPath("spec")
+
+	// Syntax, a catch-all alias is written as @example.com
+	domainName := helpers.EmailDomain(alias.Spec.Address)
+	if !helpers.IsEmail(alias.Spec.Address) && !(strings.HasPrefix(alias.Spec.Address, "@") && helpers.IsDomainName(domainName)) {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("address"), alias.Spec.Address, "must be an email address or a domain prefixed with @"))
+	}
+	if strings.TrimSpace(alias.Spec.GoTo) == "" {
+		allErrs = append(allErrs, field.Required(specPath.Child("goTo"), "at least one destination is required"))
+	}
+	for _, goTo := range strings.Split(alias.Spec.GoTo, ",") {
+		goTo = strings.TrimSpace(goTo)
+		if goTo == "" || helpers.IsEmail(goTo) || slices.Contains(aliasSpecialGoTo, goTo) {
+			continue
+		}
+		allErrs = append(allErrs, field.Invalid(specPath.Child("goTo"), goTo, "must be a comma separated list of email addresses"))
+	}
+
+	// Cross-object rules
+	fieldErr, err := validateMailcowRef(ctx, v.Client, alias.Namespace, alias.GetMailcowRef(), mailcowRefPath(specPath, alias.Spec.MailcowRef))
+	if err != nil {
+		return nil, err
+	}
+	if fieldErr != nil {
+		allErrs = append(allErrs, fieldErr)
+	}
+
+	var aliases mailcowv1.AliasList
+	if err := v.Client.List(ctx, &aliases, client.InNamespace(alias.Namespace)); err != nil {
+		return nil, err
+	}
+	for _, other := range aliases.Items {
+		if other.Name != alias.Name && other.GetMailcowRef() == alias.GetMailcowRef() && strings.EqualFold(other.Spec.Address, alias.Spec.Address) {
+			allErrs = append(allErrs, field.Duplicate(specPath.Child("address"), fmt.Sprintf("%s is already managed by Alias %s", alias.Spec.Address, other.Name)))
+		}
+	}
+
+	// Alias domains are not managed by the operator, so a missing Domain is not an error
+	if domainName != "" {
+		domain, err := mailcowv1.FindDomain(ctx, v.Client, alias.Namespace, alias.GetMailcowRef(), domainName)
+		if err != nil {
+			return nil, err
+		}
+		fieldErr, err := validateDomainOwnership(ctx, v.Client, alias.Namespace, alias.GetMailcowRef(), domainName, domain, specPath.Child("address"))
+		if err != nil {
+			return nil, err
+		}
+		if fieldErr != nil {
+			allErrs = append(allErrs, fieldErr)
+		} else if domain == nil {
+			warnings = append(warnings, fmt.Sprintf("no Domain resource found for %s, it must exist as domain or alias domain in mailcow", domainName))
+		}
+	}
+
+	if len(allErrs) == 0 {
+		return warnings, nil
+	}
+	return warnings, errors.NewInvalid(mailcowv1.GroupVersion.WithKind("Alias").GroupKind(), alias.Name, allErrs)
+}
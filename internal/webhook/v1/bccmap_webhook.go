@@ -0,0 +1,163 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	mailcowv1 "github.com/tarteo/mailcow-operator/api/v1"
+	helpers "github.com/tarteo/mailcow-operator/helpers"
+)
+
+// log is for logging in this package.
+var bccmaplog = logf.Log.WithName("bccmap-resource")
+
+// SetupBCCMapWebhookWithManager registers the webhook for BCCMap in the manager.
+func SetupBCCMapWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&mailcowv1.BCCMap{}).
+		WithValidator(&BCCMapCustomValidator{Client: mgr.GetClient()}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-mailcow-onestein-nl-v1-bccmap,mutating=false,failurePolicy=fail,sideEffects=None,groups=mailcow.onestein.nl,resources=bccmaps,verbs=create;update,versions=v1,name=vbccmap-v1.kb.io,admissionReviewVersions=v1
+
+// BCCMapCustomValidator struct is responsible for validating the BCCMap resource
+// when it is created, updated, or deleted.
+type BCCMapCustomValidator struct {
+	Client client.Reader
+}
+
+var _ webhook.CustomValidator = &BCCMapCustomValidator{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type BCCMap.
+func (v *BCCMapCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	bccmap, ok := obj.(*mailcowv1.BCCMap)
+	if !ok {
+		return nil, fmt.Errorf("expected a BCCMap object but got %T", obj)
+	}
+	bccmaplog.Info("Validation for BCCMap upon creation", "name", bccmap.GetName())
+
+	return nil, v.validateBCCMap(ctx, bccmap)
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type BCCMap.
+func (v *BCCMapCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	bccmap, ok := newObj.(*mailcowv1.BCCMap)
+	if !ok {
+		return nil, fmt.Errorf("expected a BCCMap object for the newObj but got %T", newObj)
+	}
+	oldBCCMap, ok := oldObj.(*mailcowv1.BCCMap)
+	if !ok {
+		return nil, fmt.Errorf("expected a BCCMap object for the oldObj but got %T", oldObj)
+	}
+	bccmaplog.Info("Validation for BCCMap upon update", "name", bccmap.GetName())
+
+	// Metadata only changes, e.g. finalizers, are always allowed
+	if equality.Semantic.DeepEqual(oldBCCMap.Spec, bccmap.Spec) {
+		return nil, nil
+	}
+
+	return nil, v.validateBCCMap(ctx, bccmap)
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type BCCMap.
+func (v *BCCMapCustomValidator) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *BCCMapCustomValidator) validateBCCMap(ctx context.Context, bccmap *mailcowv1.BCCMap) error {
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+
+	// Syntax, a domain is written without @
+	localDest := bccmap.Spec.LocalDest
+	if bccmap.Spec.LocalDestRef == nil && !helpers.IsDomainName(localDest) && !helpers.IsEmail(localDest) {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("localDest"), localDest, "must be a domain or an email address"))
+	}
+	if !helpers.IsEmail(bccmap.Spec.BCCDest) {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("bccDest"), bccmap.Spec.BCCDest, "must be an email address"))
+	}
+
+	// Cross-object rules
+	fieldErr, err := validateMailcowRef(ctx, v.Client, bccmap.Namespace, bccmap.GetMailcowRef(), mailcowRefPath(specPath, bccmap.Spec.MailcowRef))
+	if err != nil {
+		return err
+	}
+	if fieldErr != nil {
+		allErrs = append(allErrs, fieldErr)
+	}
+
+	localDestPath := specPath.Child("localDest")
+	if bccmap.Spec.LocalDestRef != nil {
+		localDestPath = specPath.Child("localDestRef")
+		localDest, fieldErr, err = validateLocalDestRef(ctx, v.Client, bccmap.Namespace, bccmap.GetMailcowRef(), bccmap.Spec.LocalDestRef, localDestPath)
+		if err != nil {
+			return err
+		}
+		if fieldErr != nil {
+			allErrs = append(allErrs, fieldErr)
+		}
+	}
+
+	if localDest != "" {
+		domainName := localDest
+		if strings.Contains(localDest, "@") {
+			domainName = helpers.EmailDomain(localDest)
+		}
+		domain, err := mailcowv1.FindDomain(ctx, v.Client, bccmap.Namespace, bccmap.GetMailcowRef(), domainName)
+		if err != nil {
+			return err
+		}
+		fieldErr, err := validateDomainOwnership(ctx, v.Client, bccmap.Namespace, bccmap.GetMailcowRef(), domainName, domain, localDestPath)
+		if err != nil {
+			return err
+		}
+		if fieldErr != nil {
+			allErrs = append(allErrs, fieldErr)
+		}
+	}
+
+	var bccmaps mailcowv1.BCCMapList
+	if err := v.Client.List(ctx, &bccmaps, client.InNamespace(bccmap.Namespace)); err != nil {
+		return err
+	}
+	for _, other := range bccmaps.Items {
+		if other.Name == bccmap.Name || other.GetMailcowRef() != bccmap.GetMailcowRef() || other.Spec.Type != bccmap.Spec.Type {
+			continue
+		}
+		if strings.EqualFold(other.Spec.LocalDest, bccmap.Spec.LocalDest) && equality.Semantic.DeepEqual(other.Spec.LocalDestRef, bccmap.Spec.LocalDestRef) {
+			allErrs = append(allErrs, field.Duplicate(localDestPath, fmt.Sprintf("the %s BCC map is already managed by BCCMap %s", bccmap.Spec.Type, other.Name)))
+		}
+	}
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return errors.NewInvalid(mailcowv1.GroupVersion.WithKind("BCCMap").GroupKind(), bccmap.Name, allErrs)
+}
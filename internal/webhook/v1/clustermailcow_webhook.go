@@ -0,0 +1,108 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	mailcowv1 "github.com/tarteo/mailcow-operator/api/v1"
+)
+
+// log is for logging in this package.
+var clustermailcowlog = logf.Log.WithName("clustermailcow-resource")
+
+// SetupClusterMailcowWebhookWithManager registers the webhook for ClusterMailcow in the manager.
+func SetupClusterMailcowWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&mailcowv1.ClusterMailcow{}).
+		WithValidator(&ClusterMailcowCustomValidator{}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-mailcow-onestein-nl-v1-clustermailcow,mutating=false,failurePolicy=fail,sideEffects=None,groups=mailcow.onestein.nl,resources=clustermailcows,verbs=create;update,versions=v1,name=vclustermailcow-v1.kb.io,admissionReviewVersions=v1
+
+// ClusterMailcowCustomValidator struct is responsible for validating the ClusterMailcow resource
+// when it is created, updated, or deleted.
+type ClusterMailcowCustomValidator struct{}
+
+var _ webhook.CustomValidator = &ClusterMailcowCustomValidator{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type ClusterMailcow.
+func (v *ClusterMailcowCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	clustermailcow, ok := obj.(*mailcowv1.ClusterMailcow)
+	if !ok {
+		return nil, fmt.Errorf("expected a ClusterMailcow object but got %T", obj)
+	}
+	clustermailcowlog.Info("Validation for ClusterMailcow upon creation", "name", clustermailcow.GetName())
+
+	return nil, v.validateClusterMailcow(clustermailcow)
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type ClusterMailcow.
+func (v *ClusterMailcowCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	clustermailcow, ok := newObj.(*mailcowv1.ClusterMailcow)
+	if !ok {
+		return nil, fmt.Errorf("expected a ClusterMailcow object for the newObj but got %T", newObj)
+	}
+	oldClusterMailcow, ok := oldObj.(*mailcowv1.ClusterMailcow)
+	if !ok {
+		return nil, fmt.Errorf("expected a ClusterMailcow object for the oldObj but got %T", oldObj)
+	}
+	clustermailcowlog.Info("Validation for ClusterMailcow upon update", "name", clustermailcow.GetName())
+
+	// Metadata only changes, e.g. finalizers, are always allowed
+	if equality.Semantic.DeepEqual(oldClusterMailcow.Spec, clustermailcow.Spec) {
+		return nil, nil
+	}
+
+	return nil, v.validateClusterMailcow(clustermailcow)
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type ClusterMailcow.
+func (v *ClusterMailcowCustomValidator) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *ClusterMailcowCustomValidator) validateClusterMailcow(clustermailcow *mailcowv1.ClusterMailcow) error {
+	specPath := field.NewPath("spec")
+	allErrs := validateMailcowSpec(&clustermailcow.Spec.MailcowSpec, specPath)
+
+	if clustermailcow.Spec.Namespace == "" {
+		allErrs = append(allErrs, field.Required(specPath.Child("namespace"), "namespace of the referenced secrets is required"))
+	} else {
+		for _, msg := range validation.IsDNS1123Label(clustermailcow.Spec.Namespace) {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("namespace"), clustermailcow.Spec.Namespace, msg))
+		}
+	}
+
+	allErrs = append(allErrs, validateAllowedNamespaces(clustermailcow.Spec.AllowedNamespaces, specPath.Child("allowedNamespaces"))...)
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return errors.NewInvalid(mailcowv1.GroupVersion.WithKind("ClusterMailcow").GroupKind(), clustermailcow.Name, allErrs)
+}
@@ -0,0 +1,239 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	mailcowv1 "github.com/tarteo/mailcow-operator/api/v1"
+	helpers "github.com/tarteo/mailcow-operator/helpers"
+)
+
+// log is for logging in this package.
+var domainlog = logf.Log.WithName("domain-resource")
+
+// SetupDomainWebhookWithManager registers the webhook for Domain in the manager.
+func SetupDomainWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&mailcowv1.Domain{}).
+		WithValidator(&DomainCustomValidator{Client: mgr.GetClient()}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-mailcow-onestein-nl-v1-domain,mutating=false,failurePolicy=fail,sideEffects=None,groups=mailcow.onestein.nl,resources=domains,verbs=create;update,versions=v1,name=vdomain-v1.kb.io,admissionReviewVersions=v1
+
+// DomainCustomValidator struct is responsible for validating the Domain resource
+// when it is created, updated, or deleted.
+type DomainCustomValidator struct {
+	Client client.Reader
+}
+
+var _ webhook.CustomValidator = &DomainCustomValidator{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type Domain.
+func (v *DomainCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	domain, ok := obj.(*mailcowv1.Domain)
+	if !ok {
+		return nil, fmt.Errorf("expected a Domain object but got %T", obj)
+	}
+	domainlog.Info("Validation for Domain upon creation", "name", domain.GetName())
+
+	return nil, v.validateDomain(ctx, domain)
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type Domain.
+func (v *DomainCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	domain, ok := newObj.(*mailcowv1.Domain)
+	if !ok {
+		return nil, fmt.Errorf("expected a Domain object for the newObj but got %T", newObj)
+	}
+	oldDomain, ok := oldObj.(*mailcowv1.Domain)
+	if !ok {
+		return nil, fmt.Errorf("expected a Domain object for the oldObj but got %T", oldObj)
+	}
+	domainlog.Info("Validation for Domain upon update", "name", domain.GetName())
+
+	// Metadata only changes, e.g. finalizers, are always allowed
+	if equality.Semantic.DeepEqual(oldDomain.Spec, domain.Spec) {
+		return nil, nil
+	}
+
+	return nil, v.validateDomain(ctx, domain)
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type Domain.
+func (v *DomainCustomValidator) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *DomainCustomValidator) validateDomain(ctx context.Context, domain *mailcowv1.Domain) error {
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+
+	// Syntax
+	if !helpers.IsDomainName(domain.Spec.Domain) {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("domain"), domain.Spec.Domain, "must be a fully qualified domain name, e.g. example.com"))
+	}
+	for _, limit := range []struct {
+		name  string
+		value int64
+	}{
+		{"quota", domain.Spec.Quota},
+		{"maxQuota", domain.Spec.MaxQuota},
+		{"defQuota", domain.Spec.DefQuota},
+		{"maxMailboxes", domain.Spec.MaxMailboxes},
+	} {
+		if limit.value < 0 {
+			allErrs = append(allErrs, field.Invalid(specPath.Child(limit.name), limit.value, "must not be negative"))
+		}
+	}
+	if domain.Spec.MaxQuota > domain.Spec.Quota {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("maxQuota"), domain.Spec.MaxQuota, "must not exceed quota"))
+	}
+	if domain.Spec.DefQuota > domain.Spec.MaxQuota {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("defQuota"), domain.Spec.DefQuota, "must not exceed maxQuota"))
+	}
+	if domain.Spec.RateLimit != nil && *domain.Spec.RateLimit < 0 {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("rateLimit"), *domain.Spec.RateLimit, "must not be negative"))
+	}
+	if dkim := domain.Spec.DKIM; dkim != nil {
+		dkimPath := specPath.Child("dkim")
+		if dkim.Selector != "" && len(validation.IsDNS1123Label(dkim.Selector)) > 0 {
+			allErrs = append(allErrs, field.Invalid(dkimPath.Child("selector"), dkim.Selector, "must be a lowercase DNS label, e.g. dkim"))
+		}
+		if dkim.RotationPeriod != nil && dkim.RotationPeriod.Duration < 0 {
+			allErrs = append(allErrs, field.Invalid(dkimPath.Child("rotationPeriod"), dkim.RotationPeriod.Duration.String(), "must not be negative"))
+		}
+		if dkim.RotationOverlap != nil && dkim.RotationOverlap.Duration < 0 {
+			allErrs = append(allErrs, field.Invalid(dkimPath.Child("rotationOverlap"), dkim.RotationOverlap.Duration.String(), "must not be negative"))
+		}
+		if dkim.RotationPeriod != nil && dkim.RotationOverlap != nil && dkim.RotationPeriod.Duration > 0 && dkim.RotationOverlap.Duration >= dkim.RotationPeriod.Duration {
+			allErrs = append(allErrs, field.Invalid(dkimPath.Child("rotationOverlap"), dkim.RotationOverlap.Duration.String(), "must be shorter than rotationPeriod"))
+		}
+	}
+	if dns := domain.Spec.DNS; dns != nil {
+		dnsPath := specPath.Child("dns")
+		if dns.MailHostname != "" && !helpers.IsDomainName(dns.MailHostname) {
+			allErrs = append(allErrs, field.Invalid(dnsPath.Child("mailHostname"), dns.MailHostname, "must be a fully qualified domain name, e.g. mail.example.com"))
+		}
+		for i, include := range dns.SPFIncludes {
+			// SPF include targets commonly start with an underscore label, e.g. _spf.google.com
+			if !helpers.IsDomainName(strings.ReplaceAll(include, "_", "x")) {
+				allErrs = append(allErrs, field.Invalid(dnsPath.Child("spfIncludes").Index(i), include, "must be a fully qualified domain name, e.g. _spf.google.com"))
+			}
+		}
+		for i, address := range dns.DMARCRua {
+			if !helpers.IsEmail(address) {
+				allErrs = append(allErrs, field.Invalid(dnsPath.Child("dmarcRua").Index(i), address, "must be an email address"))
+			}
+		}
+		for i, address := range dns.DMARCRuf {
+			if !helpers.IsEmail(address) {
+				allErrs = append(allErrs, field.Invalid(dnsPath.Child("dmarcRuf").Index(i), address, "must be an email address"))
+			}
+		}
+		if dns.TTL < 0 {
+			allErrs = append(allErrs, field.Invalid(dnsPath.Child("ttl"), dns.TTL, "must not be negative"))
+		}
+	}
+	allErrs = append(allErrs, validateAllowedNamespaces(domain.Spec.SharedWith, specPath.Child("sharedWith"))...)
+
+	// Cross-object rules
+	fieldErr, err := validateMailcowRef(ctx, v.Client, domain.Namespace, domain.GetMailcowRef(), mailcowRefPath(specPath, domain.Spec.MailcowRef))
+	if err != nil {
+		return err
+	}
+	if fieldErr != nil {
+		allErrs = append(allErrs, fieldErr)
+	}
+
+	existing, err := mailcowv1.FindDomain(ctx, v.Client, domain.Namespace, domain.GetMailcowRef(), domain.Spec.Domain)
+	if err != nil {
+		return err
+	}
+	if existing != nil && (existing.Name != domain.Name || existing.Namespace != domain.Namespace) {
+		allErrs = append(allErrs, field.Duplicate(specPath.Child("domain"), fmt.Sprintf("%s is already managed by Domain %s/%s", domain.Spec.Domain, existing.Namespace, existing.Name)))
+	}
+
+	if domain.Spec.RelayHost != "" {
+		relayHostPath := specPath.Child("relayHost")
+		var relayhost mailcowv1.RelayHost
+		err := v.Client.Get(ctx, types.NamespacedName{Name: domain.Spec.RelayHost, Namespace: domain.Namespace}, &relayhost)
+		switch {
+		case errors.IsNotFound(err):
+			allErrs = append(allErrs, field.NotFound(relayHostPath, domain.Spec.RelayHost))
+		case err != nil:
+			return err
+		case relayhost.GetMailcowRef() != domain.GetMailcowRef():
+			allErrs = append(allErrs, field.Invalid(relayHostPath, domain.Spec.RelayHost, fmt.Sprintf("must use the mailcow of the Domain, RelayHost uses %s", relayhost.GetMailcowRef().String())))
+		}
+	}
+
+	// The limits must still fit the mailboxes of the domain, a shared domain counts the mailboxes of all namespaces
+	var opts []client.ListOption
+	if domain.GetMailcowRef().Kind != mailcowv1.ClusterMailcowKind {
+		opts = append(opts, client.InNamespace(domain.Namespace))
+	}
+	var mailboxes mailcowv1.MailboxList
+	if err := v.Client.List(ctx, &mailboxes, opts...); err != nil {
+		return err
+	}
+	var count, total int64
+	for _, mailbox := range mailboxes.Items {
+		if mailbox.GetMailcowRef() != domain.GetMailcowRef() || !strings.EqualFold(mailbox.Spec.Domain, domain.Spec.Domain) {
+			continue
+		}
+		quota := mailboxQuota(&mailbox, domain)
+		if quota > domain.Spec.MaxQuota {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("maxQuota"), domain.Spec.MaxQuota, fmt.Sprintf("Mailbox %s/%s has a quota of %d", mailbox.Namespace, mailbox.Name, quota)))
+		}
+		count++
+		total += quota
+	}
+	if count > domain.Spec.MaxMailboxes {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("maxMailboxes"), domain.Spec.MaxMailboxes, fmt.Sprintf("domain has %d mailboxes", count)))
+	}
+	if total > domain.Spec.Quota {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("quota"), domain.Spec.Quota, fmt.Sprintf("mailboxes of the domain use a quota of %d", total)))
+	}
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return errors.NewInvalid(mailcowv1.GroupVersion.WithKind("Domain").GroupKind(), domain.Name, allErrs)
+}
+
+// mailboxQuota returns the quota of the mailbox, mailcow uses the default quota of the domain when not set.
+func mailboxQuota(mailbox *mailcowv1.Mailbox, domain *mailcowv1.Domain) int64 {
+	if mailbox.Spec.Quota != nil {
+		return *mailbox.Spec.Quota
+	}
+	return domain.Spec.DefQuota
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	mailcowv1 "github.com/tarteo/mailcow-operator/api/v1"
+	helpers "github.com/tarteo/mailcow-operator/helpers"
+)
+
+// domainAdminUsernamePattern matches the usernames mailcow accepts for domain admins.
+var domainAdminUsernamePattern = regexp.MustCompile(`^[a-zA-Z0-9._-]+$`)
+
+// log is for logging in this package.
+var domainadminlog = logf.Log.WithName("domainadmin-resource")
+
+// SetupDomainAdminWebhookWithManager registers the webhook for DomainAdmin in the manager.
+func SetupDomainAdminWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&mailcowv1.DomainAdmin{}).
+		WithValidator(&DomainAdminCustomValidator{Client: mgr.GetClient()}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-mailcow-onestein-nl-v1-domainadmin,mutating=false,failurePolicy=fail,sideEffects=None,groups=mailcow.onestein.nl,resources=domainadmins,verbs=create;update,versions=v1,name=vdomainadmin-v1.kb.io,admissionReviewVersions=v1
+
+// DomainAdminCustomValidator struct is responsible for validating the DomainAdmin resource
+// when it is created, updated, or deleted.
+type DomainAdminCustomValidator struct {
+	Client client.Reader
+}
+
+var _ webhook.CustomValidator = &DomainAdminCustomValidator{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type DomainAdmin.
+func (v *DomainAdminCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	domainadmin, ok := obj.(*mailcowv1.DomainAdmin)
+	if !ok {
+		return nil, fmt.Errorf("expected a DomainAdmin object but got %T", obj)
+	}
+	domainadminlog.Info("Validation for DomainAdmin upon creation", "name", domainadmin.GetName())
+
+	return nil, v.validateDomainAdmin(ctx, domainadmin)
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type DomainAdmin.
+func (v *DomainAdminCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	domainadmin, ok := newObj.(*mailcowv1.DomainAdmin)
+	if !ok {
+		return nil, fmt.Errorf("expected a DomainAdmin object for the newObj but got %T", newObj)
+	}
+	oldDomainAdmin, ok := oldObj.(*mailcowv1.DomainAdmin)
+	if !ok {
+		return nil, fmt.Errorf("expected a DomainAdmin object for the oldObj but got %T", oldObj)
+	}
+	domainadminlog.Info("Validation for DomainAdmin upon update", "name", domainadmin.GetName())
+
+	// Metadata only changes, e.g. finalizers, are always allowed
+	if equality.Semantic.DeepEqual(oldDomainAdmin.Spec, domainadmin.Spec) {
+		return nil, nil
+	}
+
+	return nil, v.validateDomainAdmin(ctx, domainadmin)
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type DomainAdmin.
+func (v *DomainAdminCustomValidator) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *DomainAdminCustomValidator) validateDomainAdmin(ctx context.Context, domainadmin *mailcowv1.DomainAdmin) error {
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+
+	// Syntax
+	if !domainAdminUsernamePattern.MatchString(domainadmin.Spec.Username) {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("username"), domainadmin.Spec.Username, "must only contain letters, digits, dots, dashes and underscores"))
+	}
+	allErrs = append(allErrs, validateSecretKeySelector(domainadmin.Spec.PasswordSecret, specPath.Child("passwordSecret"))...)
+	if len(domainadmin.Spec.Domains) == 0 {
+		allErrs = append(allErrs, field.Required(specPath.Child("domains"), "at least one domain is required"))
+	}
+
+	// Cross-object rules
+	fieldErr, err := validateMailcowRef(ctx, v.Client, domainadmin.Namespace, domainadmin.GetMailcowRef(), mailcowRefPath(specPath, domainadmin.Spec.MailcowRef))
+	if err != nil {
+		return err
+	}
+	if fieldErr != nil {
+		allErrs = append(allErrs, fieldErr)
+	}
+
+	for i, domainName := range domainadmin.Spec.Domains {
+		if !helpers.IsDomainName(domainName) {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("domains").Index(i), domainName, "must be a fully qualified domain name, e.g. example.com"))
+			continue
+		}
+		domain, err := mailcowv1.FindDomain(ctx, v.Client, domainadmin.Namespace, domainadmin.GetMailcowRef(), domainName)
+		if err != nil {
+			return err
+		}
+		if domain == nil {
+			allErrs = append(allErrs, field.NotFound(specPath.Child("domains").Index(i), domainName))
+			continue
+		}
+		fieldErr, err := validateDomainOwnership(ctx, v.Client, domainadmin.Namespace, domainadmin.GetMailcowRef(), domainName, domain, specPath.Child("domains").Index(i))
+		if err != nil {
+			return err
+		}
+		if fieldErr != nil {
+			allErrs = append(allErrs, fieldErr)
+		}
+	}
+
+	var domainadmins mailcowv1.DomainAdminList
+	if err := v.Client.List(ctx, &domainadmins, client.InNamespace(domainadmin.Namespace)); err != nil {
+		return err
+	}
+	for _, other := range domainadmins.Items {
+		if other.Name != domainadmin.Name && other.GetMailcowRef() == domainadmin.GetMailcowRef() && other.Spec.Username == domainadmin.Spec.Username {
+			allErrs = append(allErrs, field.Duplicate(specPath.Child("username"), fmt.Sprintf("%s is already managed by DomainAdmin %s", domainadmin.Spec.Username, other.Name)))
+		}
+	}
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return errors.NewInvalid(mailcowv1.GroupVersion.WithKind("DomainAdmin").GroupKind(), domainadmin.Name, allErrs)
+}
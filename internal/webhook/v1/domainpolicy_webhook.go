@@ -0,0 +1,160 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	mailcowv1 "github.com/tarteo/mailcow-operator/api/v1"
+	helpers "github.com/tarteo/mailcow-operator/helpers"
+)
+
+// log is for logging in this package.
+var domainpolicylog = logf.Log.WithName("domainpolicy-resource")
+
+// SetupDomainPolicyWebhookWithManager registers the webhook for DomainPolicy in the manager.
+func SetupDomainPolicyWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&mailcowv1.DomainPolicy{}).
+		WithValidator(&DomainPolicyCustomValidator{Client: mgr.GetClient()}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-mailcow-onestein-nl-v1-domainpolicy,mutating=false,failurePolicy=fail,sideEffects=None,groups=mailcow.onestein.nl,resources=domainpolicies,verbs=create;update,versions=v1,name=vdomainpolicy-v1.kb.io,admissionReviewVersions=v1
+
+// DomainPolicyCustomValidator struct is responsible for validating the DomainPolicy resource
+// when it is created, updated, or deleted.
+type DomainPolicyCustomValidator struct {
+	Client client.Reader
+}
+
+var _ webhook.CustomValidator = &DomainPolicyCustomValidator{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type DomainPolicy.
+func (v *DomainPolicyCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	domainPolicy, ok := obj.(*mailcowv1.DomainPolicy)
+	if !ok {
+		return nil, fmt.Errorf("expected a DomainPolicy object but got %T", obj)
+	}
+	domainpolicylog.Info("Validation for DomainPolicy upon creation", "name", domainPolicy.GetName())
+
+	return nil, v.validateDomainPolicy(ctx, domainPolicy)
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type DomainPolicy.
+func (v *DomainPolicyCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	domainPolicy, ok := newObj.(*mailcowv1.DomainPolicy)
+	if !ok {
+		return nil, fmt.Errorf("expected a DomainPolicy object for the newObj but got %T", newObj)
+	}
+	oldDomainPolicy, ok := oldObj.(*mailcowv1.DomainPolicy)
+	if !ok {
+		return nil, fmt.Errorf("expected a DomainPolicy object for the oldObj but got %T", oldObj)
+	}
+	domainpolicylog.Info("Validation for DomainPolicy upon update", "name", domainPolicy.GetName())
+
+	// Metadata only changes, e.g. finalizers, are always allowed
+	if equality.Semantic.DeepEqual(oldDomainPolicy.Spec, domainPolicy.Spec) {
+		return nil, nil
+	}
+
+	return nil, v.validateDomainPolicy(ctx, domainPolicy)
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type DomainPolicy.
+func (v *DomainPolicyCustomValidator) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *DomainPolicyCustomValidator) validateDomainPolicy(ctx context.Context, domainPolicy *mailcowv1.DomainPolicy) error {
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+	domainPath := specPath.Child("domain")
+
+	// Syntax
+	domainName := domainPolicy.Spec.Domain
+	if !helpers.IsDomainName(domainName) {
+		allErrs = append(allErrs, field.Invalid(domainPath, domainName, "must be a domain"))
+	}
+	allowed := make(map[string]bool, len(domainPolicy.Spec.Allow))
+	for i, value := range domainPolicy.Spec.Allow {
+		if !helpers.IsPolicyAddress(value) {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("allow").Index(i), value, "must be an email address, a domain or a wildcard like *@example.com"))
+		}
+		allowed[strings.ToLower(value)] = true
+	}
+	for i, value := range domainPolicy.Spec.Deny {
+		if !helpers.IsPolicyAddress(value) {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("deny").Index(i), value, "must be an email address, a domain or a wildcard like *@example.com"))
+		}
+		if allowed[strings.ToLower(value)] {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("deny").Index(i), value, "must not be in allow"))
+		}
+	}
+
+	// Cross-object rules
+	fieldErr, err := validateMailcowRef(ctx, v.Client, domainPolicy.Namespace, domainPolicy.GetMailcowRef(), mailcowRefPath(specPath, domainPolicy.Spec.MailcowRef))
+	if err != nil {
+		return err
+	}
+	if fieldErr != nil {
+		allErrs = append(allErrs, fieldErr)
+	}
+
+	if domainName != "" {
+		domain, err := mailcowv1.FindDomain(ctx, v.Client, domainPolicy.Namespace, domainPolicy.GetMailcowRef(), domainName)
+		if err != nil {
+			return err
+		}
+		fieldErr, err := validateDomainOwnership(ctx, v.Client, domainPolicy.Namespace, domainPolicy.GetMailcowRef(), domainName, domain, domainPath)
+		if err != nil {
+			return err
+		}
+		if fieldErr != nil {
+			allErrs = append(allErrs, fieldErr)
+		}
+	}
+
+	var domainPolicies mailcowv1.DomainPolicyList
+	if err := v.Client.List(ctx, &domainPolicies, client.InNamespace(domainPolicy.Namespace)); err != nil {
+		return err
+	}
+	for _, other := range domainPolicies.Items {
+		if other.Name == domainPolicy.Name || other.GetMailcowRef() != domainPolicy.GetMailcowRef() {
+			continue
+		}
+		if strings.EqualFold(other.Spec.Domain, domainName) {
+			allErrs = append(allErrs, field.Duplicate(domainPath, fmt.Sprintf("the policy of the domain is already managed by DomainPolicy %s", other.Name)))
+		}
+	}
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return errors.NewInvalid(mailcowv1.GroupVersion.WithKind("DomainPolicy").GroupKind(), domainPolicy.Name, allErrs)
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	mailcowv1 "github.com/tarteo/mailcow-operator/api/v1"
+	helpers "github.com/tarteo/mailcow-operator/helpers"
+)
+
+// log is for logging in this package.
+var mailboxlog = logf.Log.WithName("mailbox-resource")
+
+// SetupMailboxWebhookWithManager registers the webhook for Mailbox in the manager.
+func SetupMailboxWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&mailcowv1.Mailbox{}).
+		WithValidator(&MailboxCustomValidator{Client: mgr.GetClient()}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-mailcow-onestein-nl-v1-mailbox,mutating=false,failurePolicy=fail,sideEffects=None,groups=mailcow.onestein.nl,resources=mailboxes,verbs=create;update,versions=v1,name=vmailbox-v1.kb.io,admissionReviewVersions=v1
+
+// MailboxCustomValidator struct is responsible for validating the Mailbox resource
+// when it is created, updated, or deleted.
+type MailboxCustomValidator struct {
+	Client client.Reader
+}
+
+var _ webhook.CustomValidator = &MailboxCustomValidator{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type Mailbox.
+func (v *MailboxCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	mailbox, ok := obj.(*mailcowv1.Mailbox)
+	if !ok {
+		return nil, fmt.Errorf("expected a Mailbox object but got %T", obj)
+	}
+	mailboxlog.Info("Validation for Mailbox upon creation", "name", mailbox.GetName())
+
+	return nil, v.validateMailbox(ctx, mailbox)
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type Mailbox.
+func (v *MailboxCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	mailbox, ok := newObj.(*mailcowv1.Mailbox)
+	if !ok {
+		return nil, fmt.Errorf("expected a Mailbox object for the newObj but got %T", newObj)
+	}
+	oldMailbox, ok := oldObj.(*mailcowv1.Mailbox)
+	if !ok {
+		return nil, fmt.Errorf("expected a Mailbox object for the oldObj but got %T", oldObj)
+	}
+	mailboxlog.Info("Validation for Mailbox upon update", "name", mailbox.GetName())
+
+	// Metadata only changes, e.g. finalizers, are always allowed
+	if equality.Semantic.DeepEqual(oldMailbox.Spec, mailbox.Spec) {
+		return nil, nil
+	}
+
+	return nil, v.validateMailbox(ctx, mailbox)
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type Mailbox.
+func (v *MailboxCustomValidator) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *MailboxCustomValidator) validateMailbox(ctx context.Context, mailbox *mailcowv1.Mailbox) error {
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+
+	// Syntax
+	email := mailbox.Spec.LocalPart + "@" + mailbox.Spec.Domain
+	if !helpers.IsDomainName(mailbox.Spec.Domain) {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("domain"), mailbox.Spec.Domain, "must be a fully qualified domain name, e.g. example.com"))
+	} else if mailbox.Spec.LocalPart == "" || strings.Contains(mailbox.Spec.LocalPart, "@") || !helpers.IsEmail(email) {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("localPart"), mailbox.Spec.LocalPart, "must be the part before the @ of an email address"))
+	}
+	allErrs = append(allErrs, validateSecretKeySelector(mailbox.Spec.PasswordSecret, specPath.Child("passwordSecret"))...)
+	if mailbox.Spec.Quota != nil && *mailbox.Spec.Quota < 0 {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("quota"), *mailbox.Spec.Quota, "must not be negative"))
+	}
+	if mailbox.Spec.SenderACL != nil {
+		for i, sender := range *mailbox.Spec.SenderACL {
+			if sender == "*" || (strings.HasPrefix(sender, "@") && helpers.IsDomainName(sender[1:])) || helpers.IsEmail(sender) {
+				continue
+			}
+			allErrs = append(allErrs, field.Invalid(specPath.Child("senderACL").Index(i), sender, "must be an email address, a domain prefixed with @ or *"))
+		}
+	}
+
+	// Cross-object rules
+	fieldErr, err := validateMailcowRef(ctx, v.Client, mailbox.Namespace, mailbox.GetMailcowRef(), mailcowRefPath(specPath, mailbox.Spec.MailcowRef))
+	if err != nil {
+		return err
+	}
+	if fieldErr != nil {
+		allErrs = append(allErrs, fieldErr)
+	}
+
+	domain, err := mailcowv1.FindDomain(ctx, v.Client, mailbox.Namespace, mailbox.GetMailcowRef(), mailbox.Spec.Domain)
+	if err != nil {
+		return err
+	}
+	// Only a domain of a ClusterMailcow must have a Domain resource, the limits are checked when there is one
+	fieldErr, err = validateDomainOwnership(ctx, v.Client, mailbox.Namespace, mailbox.GetMailcowRef(), mailbox.Spec.Domain, domain, specPath.Child("domain"))
+	if err != nil {
+		return err
+	}
+	if fieldErr != nil {
+		allErrs = append(allErrs, fieldErr)
+	}
+
+	var quota int64
+	if domain != nil {
+		quota = mailboxQuota(mailbox, domain)
+		if quota > domain.Spec.MaxQuota {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("quota"), quota, fmt.Sprintf("must not exceed maxQuota %d of Domain %s", domain.Spec.MaxQuota, domain.Name)))
+		}
+	}
+
+	// A shared domain counts the mailboxes of all namespaces
+	var opts []client.ListOption
+	if mailbox.GetMailcowRef().Kind != mailcowv1.ClusterMailcowKind {
+		opts = append(opts, client.InNamespace(mailbox.Namespace))
+	}
+	var mailboxes mailcowv1.MailboxList
+	if err := v.Client.List(ctx, &mailboxes, opts...); err != nil {
+		return err
+	}
+	count, total := int64(1), quota
+	for _, other := range mailboxes.Items {
+		if (other.Name == mailbox.Name && other.Namespace == mailbox.Namespace) || other.GetMailcowRef() != mailbox.GetMailcowRef() || !strings.EqualFold(other.Spec.Domain, mailbox.Spec.Domain) {
+			continue
+		}
+		if strings.EqualFold(other.Spec.LocalPart, mailbox.Spec.LocalPart) {
+			allErrs = append(allErrs, field.Duplicate(specPath.Child("localPart"), fmt.Sprintf("%s is already managed by Mailbox %s/%s", email, other.Namespace, other.Name)))
+		}
+		if domain != nil {
+			count++
+			total += mailboxQuota(&other, domain)
+		}
+	}
+	if domain != nil && count > domain.Spec.MaxMailboxes {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("domain"), fmt.Sprintf("Domain %s allows at most %d mailboxes", domain.Name, domain.Spec.MaxMailboxes)))
+	}
+	if domain != nil && total > domain.Spec.Quota {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("quota"), quota, fmt.Sprintf("mailboxes would use a quota of %d, exceeding quota %d of Domain %s", total, domain.Spec.Quota, domain.Name)))
+	}
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return errors.NewInvalid(mailcowv1.GroupVersion.WithKind("Mailbox").GroupKind(), mailbox.Name, allErrs)
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	mailcowv1 "github.com/tarteo/mailcow-operator/api/v1"
+)
+
+// log is for logging in this package.
+var mailcowlog = logf.Log.WithName("mailcow-resource")
+
+// SetupMailcowWebhookWithManager registers the webhook for Mailcow in the manager.
+func SetupMailcowWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&mailcowv1.Mailcow{}).
+		WithValidator(&MailcowCustomValidator{}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-mailcow-onestein-nl-v1-mailcow,mutating=false,failurePolicy=fail,sideEffects=None,groups=mailcow.onestein.nl,resources=mailcows,verbs=create;update,versions=v1,name=vmailcow-v1.kb.io,admissionReviewVersions=v1
+
+// MailcowCustomValidator struct is responsible for validating the Mailcow resource
+// when it is created, updated, or deleted.
+type MailcowCustomValidator struct{}
+
+var _ webhook.CustomValidator = &MailcowCustomValidator{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type Mailcow.
+func (v *MailcowCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	mailcow, ok := obj.(*mailcowv1.Mailcow)
+	if !ok {
+		return nil, fmt.Errorf("expected a Mailcow object but got %T", obj)
+	}
+	mailcowlog.Info("Validation for Mailcow upon creation", "name", mailcow.GetName())
+
+	return nil, v.validateMailcow(mailcow)
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type Mailcow.
+func (v *MailcowCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	mailcow, ok := newObj.(*mailcowv1.Mailcow)
+	if !ok {
+		return nil, fmt.Errorf("expected a Mailcow object for the newObj but got %T", newObj)
+	}
+	oldMailcow, ok := oldObj.(*mailcowv1.Mailcow)
+	if !ok {
+		return nil, fmt.Errorf("expected a Mailcow object for the oldObj but got %T", oldObj)
+	}
+	mailcowlog.Info("Validation for Mailcow upon update", "name", mailcow.GetName())
+
+	// Metadata only changes, e.g. finalizers, are always allowed
+	if equality.Semantic.DeepEqual(oldMailcow.Spec, mailcow.Spec) {
+		return nil, nil
+	}
+
+	return nil, v.validateMailcow(mailcow)
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type Mailcow.
+func (v *MailcowCustomValidator) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *MailcowCustomValidator) validateMailcow(mailcow *mailcowv1.Mailcow) error {
+	allErrs := validateMailcowSpec(&mailcow.Spec, field.NewPath("spec"))
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return errors.NewInvalid(mailcowv1.GroupVersion.WithKind("Mailcow").GroupKind(), mailcow.Name, allErrs)
+}
+
+// validateMailcowSpec validates the spec shared by Mailcow and ClusterMailcow.
+func validateMailcowSpec(spec *mailcowv1.MailcowSpec, specPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	endpoint, err := url.Parse(spec.Endpoint)
+	if err != nil {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("endpoint"), spec.Endpoint, err.Error()))
+	} else if (endpoint.Scheme != "http" && endpoint.Scheme != "https") || endpoint.Host == "" {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("endpoint"), spec.Endpoint, "must be an http or https url, e.g. https://mail.example.com"))
+	}
+
+	allErrs = append(allErrs, validateSecretKeySelector(spec.Secret, specPath.Child("secret"))...)
+
+	if spec.HealthCheckInterval != nil && spec.HealthCheckInterval.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("healthCheckInterval"), spec.HealthCheckInterval.Duration.String(), "must be greater than zero"))
+	}
+	if spec.ResyncInterval != nil && spec.ResyncInterval.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("resyncInterval"), spec.ResyncInterval.Duration.String(), "must not be negative"))
+	}
+
+	if spec.Timeout != nil && spec.Timeout.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("timeout"), spec.Timeout.Duration.String(), "must not be negative"))
+	}
+	if spec.Proxy != "" {
+		proxy, err := url.Parse(spec.Proxy)
+		if err != nil || (proxy.Scheme != "http" && proxy.Scheme != "https") || proxy.Host == "" {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("proxy"), spec.Proxy, "must be an http or https url, e.g. http://proxy.example.com:3128"))
+		}
+	}
+	if tls := spec.TLS; tls != nil {
+		tlsPath := specPath.Child("tls")
+		if tls.CA != nil && tls.CA.ConfigMap != nil && (tls.CA.ConfigMap.Name == "" || tls.CA.ConfigMap.Key == "") {
+			allErrs = append(allErrs, field.Required(tlsPath.Child("ca", "configMap"), "name and key are required"))
+		}
+		if tls.CA != nil && tls.CA.Secret != nil {
+			allErrs = append(allErrs, validateSecretKeySelector(*tls.CA.Secret, tlsPath.Child("ca", "secret"))...)
+		}
+		if tls.ClientCertificate != nil && tls.ClientCertificate.Name == "" {
+			allErrs = append(allErrs, field.Required(tlsPath.Child("clientCertificate", "name"), "name of a kubernetes.io/tls secret is required"))
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	mailcowv1 "github.com/tarteo/mailcow-operator/api/v1"
+	helpers "github.com/tarteo/mailcow-operator/helpers"
+)
+
+// log is for logging in this package.
+var recipientmaplog = logf.Log.WithName("recipientmap-resource")
+
+// SetupRecipientMapWebhookWithManager registers the webhook for RecipientMap in the manager.
+func SetupRecipientMapWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&mailcowv1.RecipientMap{}).
+		WithValidator(&RecipientMapCustomValidator{Client: mgr.GetClient()}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-mailcow-onestein-nl-v1-recipientmap,mutating=false,failurePolicy=fail,sideEffects=None,groups=mailcow.onestein.nl,resources=recipientmaps,verbs=create;update,versions=v1,name=vrecipientmap-v1.kb.io,admissionReviewVersions=v1
+
+// RecipientMapCustomValidator struct is responsible for validating the RecipientMap resource
+// when it is created, updated, or deleted.
+type RecipientMapCustomValidator struct {
+	Client client.Reader
+}
+
+var _ webhook.CustomValidator = &RecipientMapCustomValidator{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type RecipientMap.
+func (v *RecipientMapCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	recipientMap, ok := obj.(*mailcowv1.RecipientMap)
+	if !ok {
+		return nil, fmt.Errorf("expected a RecipientMap object but got %T", obj)
+	}
+	recipientmaplog.Info("Validation for RecipientMap upon creation", "name", recipientMap.GetName())
+
+	return nil, v.validateRecipientMap(ctx, recipientMap)
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type RecipientMap.
+func (v *RecipientMapCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	recipientMap, ok := newObj.(*mailcowv1.RecipientMap)
+	if !ok {
+		return nil, fmt.Errorf("expected a RecipientMap object for the newObj but got %T", newObj)
+	}
+	oldRecipientMap, ok := oldObj.(*mailcowv1.RecipientMap)
+	if !ok {
+		return nil, fmt.Errorf("expected a RecipientMap object for the oldObj but got %T", oldObj)
+	}
+	recipientmaplog.Info("Validation for RecipientMap upon update", "name", recipientMap.GetName())
+
+	// Metadata only changes, e.g. finalizers, are always allowed
+	if equality.Semantic.DeepEqual(oldRecipientMap.Spec, recipientMap.Spec) {
+		return nil, nil
+	}
+
+	return nil, v.validateRecipientMap(ctx, recipientMap)
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type RecipientMap.
+func (v *RecipientMapCustomValidator) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *RecipientMapCustomValidator) validateRecipientMap(ctx context.Context, recipientMap *mailcowv1.RecipientMap) error {
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+	oldRecipientPath := specPath.Child("oldRecipient")
+
+	// Syntax, a domain is written without @
+	oldRecipient := recipientMap.Spec.OldRecipient
+	if !helpers.IsDomainName(oldRecipient) && !helpers.IsEmail(oldRecipient) {
+		allErrs = append(allErrs, field.Invalid(oldRecipientPath, oldRecipient, "must be a domain or an email address"))
+	}
+	if !helpers.IsEmail(recipientMap.Spec.NewRecipient) {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("newRecipient"), recipientMap.Spec.NewRecipient, "must be an email address"))
+	}
+
+	// Cross-object rules
+	fieldErr, err := validateMailcowRef(ctx, v.Client, recipientMap.Namespace, recipientMap.GetMailcowRef(), mailcowRefPath(specPath, recipientMap.Spec.MailcowRef))
+	if err != nil {
+		return err
+	}
+	if fieldErr != nil {
+		allErrs = append(allErrs, fieldErr)
+	}
+
+	if oldRecipient != "" {
+		domainName := oldRecipient
+		if strings.Contains(oldRecipient, "@") {
+			domainName = helpers.EmailDomain(oldRecipient)
+		}
+		domain, err := mailcowv1.FindDomain(ctx, v.Client, recipientMap.Namespace, recipientMap.GetMailcowRef(), domainName)
+		if err != nil {
+			return err
+		}
+		fieldErr, err := validateDomainOwnership(ctx, v.Client, recipientMap.Namespace, recipientMap.GetMailcowRef(), domainName, domain, oldRecipientPath)
+		if err != nil {
+			return err
+		}
+		if fieldErr != nil {
+			allErrs = append(allErrs, fieldErr)
+		}
+	}
+
+	var recipientMaps mailcowv1.RecipientMapList
+	if err := v.Client.List(ctx, &recipientMaps, client.InNamespace(recipientMap.Namespace)); err != nil {
+		return err
+	}
+	for _, other := range recipientMaps.Items {
+		if other.Name == recipientMap.Name || other.GetMailcowRef() != recipientMap.GetMailcowRef() {
+			continue
+		}
+		if strings.EqualFold(other.Spec.OldRecipient, oldRecipient) {
+			allErrs = append(allErrs, field.Duplicate(oldRecipientPath, fmt.Sprintf("the recipient map is already managed by RecipientMap %s", other.Name)))
+		}
+	}
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return errors.NewInvalid(mailcowv1.GroupVersion.WithKind("RecipientMap").GroupKind(), recipientMap.Name, allErrs)
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	mailcowv1 "github.com/tarteo/mailcow-operator/api/v1"
+	helpers "github.com/tarteo/mailcow-operator/helpers"
+)
+
+// log is for logging in this package.
+var relayhostlog = logf.Log.WithName("relayhost-resource")
+
+// SetupRelayHostWebhookWithManager registers the webhook for RelayHost in the manager.
+func SetupRelayHostWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&mailcowv1.RelayHost{}).
+		WithValidator(&RelayHostCustomValidator{Client: mgr.GetClient()}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-mailcow-onestein-nl-v1-relayhost,mutating=false,failurePolicy=fail,sideEffects=None,groups=mailcow.onestein.nl,resources=relayhosts,verbs=create;update,versions=v1,name=vrelayhost-v1.kb.io,admissionReviewVersions=v1
+
+// RelayHostCustomValidator struct is responsible for validating the RelayHost resource
+// when it is created, updated, or deleted.
+type RelayHostCustomValidator struct {
+	Client client.Reader
+}
+
+var _ webhook.CustomValidator = &RelayHostCustomValidator{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type RelayHost.
+func (v *RelayHostCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	relayhost, ok := obj.(*mailcowv1.RelayHost)
+	if !ok {
+		return nil, fmt.Errorf("expected a RelayHost object but got %T", obj)
+	}
+	relayhostlog.Info("Validation for RelayHost upon creation", "name", relayhost.GetName())
+
+	return nil, v.validateRelayHost(ctx, relayhost)
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type RelayHost.
+func (v *RelayHostCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	relayhost, ok := newObj.(*mailcowv1.RelayHost)
+	if !ok {
+		return nil, fmt.Errorf("expected a RelayHost object for the newObj but got %T", newObj)
+	}
+	oldRelayHost, ok := oldObj.(*mailcowv1.RelayHost)
+	if !ok {
+		return nil, fmt.Errorf("expected a RelayHost object for the oldObj but got %T", oldObj)
+	}
+	relayhostlog.Info("Validation for RelayHost upon update", "name", relayhost.GetName())
+
+	// Metadata only changes, e.g. finalizers, are always allowed
+	if equality.Semantic.DeepEqual(oldRelayHost.Spec, relayhost.Spec) {
+		return nil, nil
+	}
+
+	return nil, v.validateRelayHost(ctx, relayhost)
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type RelayHost.
+func (v *RelayHostCustomValidator) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *RelayHostCustomValidator) validateRelayHost(ctx context.Context, relayhost *mailcowv1.RelayHost) error {
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+	hostnamePath := specPath.Child("hostname")
+
+	// Syntax
+	if !helpers.IsHostPort(relayhost.Spec.Hostname) {
+		allErrs = append(allErrs, field.Invalid(hostnamePath, relayhost.Spec.Hostname, "must be a host with an optional port, e.g. smtp.example.com:587"))
+	}
+	if relayhost.Spec.PasswordSecret != nil {
+		allErrs = append(allErrs, validateSecretKeySelector(*relayhost.Spec.PasswordSecret, specPath.Child("passwordSecret"))...)
+	}
+
+	// Cross-object rules
+	fieldErr, err := validateMailcowRef(ctx, v.Client, relayhost.Namespace, relayhost.GetMailcowRef(), mailcowRefPath(specPath, relayhost.Spec.MailcowRef))
+	if err != nil {
+		return err
+	}
+	if fieldErr != nil {
+		allErrs = append(allErrs, fieldErr)
+	}
+
+	var relayhosts mailcowv1.RelayHostList
+	if err := v.Client.List(ctx, &relayhosts, client.InNamespace(relayhost.Namespace)); err != nil {
+		return err
+	}
+	for _, other := range relayhosts.Items {
+		if other.Name == relayhost.Name || other.GetMailcowRef() != relayhost.GetMailcowRef() {
+			continue
+		}
+		if strings.EqualFold(other.Spec.Hostname, relayhost.Spec.Hostname) && other.Spec.Username == relayhost.Spec.Username {
+			allErrs = append(allErrs, field.Duplicate(hostnamePath, fmt.Sprintf("the relayhost is already managed by RelayHost %s", other.Name)))
+		}
+	}
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return errors.NewInvalid(mailcowv1.GroupVersion.WithKind("RelayHost").GroupKind(), relayhost.Name, allErrs)
+}
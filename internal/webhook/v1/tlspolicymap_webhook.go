@@ -0,0 +1,153 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	mailcowv1 "github.com/tarteo/mailcow-operator/api/v1"
+	helpers "github.com/tarteo/mailcow-operator/helpers"
+)
+
+// log is for logging in this package.
+var tlspolicymaplog = logf.Log.WithName("tlspolicymap-resource")
+
+// SetupTLSPolicyMapWebhookWithManager registers the webhook for TLSPolicyMap in the manager.
+func SetupTLSPolicyMapWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&mailcowv1.TLSPolicyMap{}).
+		WithValidator(&TLSPolicyMapCustomValidator{Client: mgr.GetClient()}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-mailcow-onestein-nl-v1-tlspolicymap,mutating=false,failurePolicy=fail,sideEffects=None,groups=mailcow.onestein.nl,resources=tlspolicymaps,verbs=create;update,versions=v1,name=vtlspolicymap-v1.kb.io,admissionReviewVersions=v1
+
+// TLSPolicyMapCustomValidator struct is responsible for validating the TLSPolicyMap resource
+// when it is created, updated, or deleted.
+type TLSPolicyMapCustomValidator struct {
+	Client client.Reader
+}
+
+var _ webhook.CustomValidator = &TLSPolicyMapCustomValidator{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type TLSPolicyMap.
+func (v *TLSPolicyMapCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	tlsPolicyMap, ok := obj.(*mailcowv1.TLSPolicyMap)
+	if !ok {
+		return nil, fmt.Errorf("expected a TLSPolicyMap object but got %T", obj)
+	}
+	tlspolicymaplog.Info("Validation for TLSPolicyMap upon creation", "name", tlsPolicyMap.GetName())
+
+	return nil, v.validateTLSPolicyMap(ctx, tlsPolicyMap)
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type TLSPolicyMap.
+func (v *TLSPolicyMapCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	tlsPolicyMap, ok := newObj.(*mailcowv1.TLSPolicyMap)
+	if !ok {
+		return nil, fmt.Errorf("expected a TLSPolicyMap object for the newObj but got %T", newObj)
+	}
+	oldTLSPolicyMap, ok := oldObj.(*mailcowv1.TLSPolicyMap)
+	if !ok {
+		return nil, fmt.Errorf("expected a TLSPolicyMap object for the oldObj but got %T", oldObj)
+	}
+	tlspolicymaplog.Info("Validation for TLSPolicyMap upon update", "name", tlsPolicyMap.GetName())
+
+	// Metadata only changes, e.g. finalizers, are always allowed
+	if equality.Semantic.DeepEqual(oldTLSPolicyMap.Spec, tlsPolicyMap.Spec) {
+		return nil, nil
+	}
+
+	return nil, v.validateTLSPolicyMap(ctx, tlsPolicyMap)
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type TLSPolicyMap.
+func (v *TLSPolicyMapCustomValidator) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *TLSPolicyMapCustomValidator) validateTLSPolicyMap(ctx context.Context, tlsPolicyMap *mailcowv1.TLSPolicyMap) error {
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+	destPath := specPath.Child("dest")
+
+	// Syntax, a domain is written without @
+	dest := tlsPolicyMap.Spec.Dest
+	if !helpers.IsDomainName(dest) && !helpers.IsEmail(dest) {
+		allErrs = append(allErrs, field.Invalid(destPath, dest, "must be a domain or an email address"))
+	}
+	// Postfix defers the mail when a fingerprint policy has nothing to match against
+	if tlsPolicyMap.Spec.Policy == mailcowv1.TLSPolicyFingerprint && !strings.Contains(tlsPolicyMap.Spec.Parameters, "match=") {
+		allErrs = append(allErrs, field.Required(specPath.Child("parameters"), "the fingerprint policy needs a match= parameter"))
+	}
+
+	// Cross-object rules
+	fieldErr, err := validateMailcowRef(ctx, v.Client, tlsPolicyMap.Namespace, tlsPolicyMap.GetMailcowRef(), mailcowRefPath(specPath, tlsPolicyMap.Spec.MailcowRef))
+	if err != nil {
+		return err
+	}
+	if fieldErr != nil {
+		allErrs = append(allErrs, fieldErr)
+	}
+
+	if helpers.IsDomainName(dest) || helpers.IsEmail(dest) {
+		domainName := dest
+		if strings.Contains(dest, "@") {
+			domainName = helpers.EmailDomain(dest)
+		}
+		domain, err := mailcowv1.FindDomain(ctx, v.Client, tlsPolicyMap.Namespace, tlsPolicyMap.GetMailcowRef(), domainName)
+		if err != nil {
+			return err
+		}
+		fieldErr, err := validateDomainOwnership(ctx, v.Client, tlsPolicyMap.Namespace, tlsPolicyMap.GetMailcowRef(), domainName, domain, destPath)
+		if err != nil {
+			return err
+		}
+		if fieldErr != nil {
+			allErrs = append(allErrs, fieldErr)
+		}
+	}
+
+	var tlsPolicyMaps mailcowv1.TLSPolicyMapList
+	if err := v.Client.List(ctx, &tlsPolicyMaps, duplicateListOptions(tlsPolicyMap.Namespace, tlsPolicyMap.GetMailcowRef())...); err != nil {
+		return err
+	}
+	for _, other := range tlsPolicyMaps.Items {
+		if (other.Namespace == tlsPolicyMap.Namespace && other.Name == tlsPolicyMap.Name) || other.GetMailcowRef() != tlsPolicyMap.GetMailcowRef() {
+			continue
+		}
+		if strings.EqualFold(other.Spec.Dest, dest) {
+			allErrs = append(allErrs, field.Duplicate(destPath, fmt.Sprintf("the TLS policy of the destination is already managed by TLSPolicyMap %s/%s", other.Namespace, other.Name)))
+		}
+	}
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return errors.NewInvalid(mailcowv1.GroupVersion.WithKind("TLSPolicyMap").GroupKind(), tlsPolicyMap.Name, allErrs)
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	mailcowv1 "github.com/tarteo/mailcow-operator/api/v1"
+	helpers "github.com/tarteo/mailcow-operator/helpers"
+)
+
+// log is for logging in this package.
+var transportmaplog = logf.Log.WithName("transportmap-resource")
+
+// SetupTransportMapWebhookWithManager registers the webhook for TransportMap in the manager.
+func SetupTransportMapWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&mailcowv1.TransportMap{}).
+		WithValidator(&TransportMapCustomValidator{Client: mgr.GetClient()}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-mailcow-onestein-nl-v1-transportmap,mutating=false,failurePolicy=fail,sideEffects=None,groups=mailcow.onestein.nl,resources=transportmaps,verbs=create;update,versions=v1,name=vtransportmap-v1.kb.io,admissionReviewVersions=v1
+
+// TransportMapCustomValidator struct is responsible for validating the TransportMap resource
+// when it is created, updated, or deleted.
+type TransportMapCustomValidator struct {
+	Client client.Reader
+}
+
+var _ webhook.CustomValidator = &TransportMapCustomValidator{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type TransportMap.
+func (v *TransportMapCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	transportMap, ok := obj.(*mailcowv1.TransportMap)
+	if !ok {
+		return nil, fmt.Errorf("expected a TransportMap object but got %T", obj)
+	}
+	transportmaplog.Info("Validation for TransportMap upon creation", "name", transportMap.GetName())
+
+	return nil, v.validateTransportMap(ctx, transportMap)
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type TransportMap.
+func (v *TransportMapCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	transportMap, ok := newObj.(*mailcowv1.TransportMap)
+	if !ok {
+		return nil, fmt.Errorf("expected a TransportMap object for the newObj but got %T", newObj)
+	}
+	oldTransportMap, ok := oldObj.(*mailcowv1.TransportMap)
+	if !ok {
+		return nil, fmt.Errorf("expected a TransportMap object for the oldObj but got %T", oldObj)
+	}
+	transportmaplog.Info("Validation for TransportMap upon update", "name", transportMap.GetName())
+
+	// Metadata only changes, e.g. finalizers, are always allowed
+	if equality.Semantic.DeepEqual(oldTransportMap.Spec, transportMap.Spec) {
+		return nil, nil
+	}
+
+	return nil, v.validateTransportMap(ctx, transportMap)
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type TransportMap.
+func (v *TransportMapCustomValidator) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *TransportMapCustomValidator) validateTransportMap(ctx context.Context, transportMap *mailcowv1.TransportMap) error {
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+	destinationPath := specPath.Child("destination")
+
+	// Syntax, a domain is written without @
+	destination := transportMap.Spec.Destination
+	if !helpers.IsDomainName(destination) && !helpers.IsEmail(destination) {
+		allErrs = append(allErrs, field.Invalid(destinationPath, destination, "must be a domain or an email address"))
+	}
+	if !helpers.IsHostPort(transportMap.Spec.Nexthop) {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("nexthop"), transportMap.Spec.Nexthop, "must be a host with an optional port, e.g. [smtp.example.com]:587"))
+	}
+	if transportMap.Spec.PasswordSecret != nil {
+		allErrs = append(allErrs, validateSecretKeySelector(*transportMap.Spec.PasswordSecret, specPath.Child("passwordSecret"))...)
+	}
+
+	// Cross-object rules
+	fieldErr, err := validateMailcowRef(ctx, v.Client, transportMap.Namespace, transportMap.GetMailcowRef(), mailcowRefPath(specPath, transportMap.Spec.MailcowRef))
+	if err != nil {
+		return err
+	}
+	if fieldErr != nil {
+		allErrs = append(allErrs, fieldErr)
+	}
+
+	if helpers.IsDomainName(destination) || helpers.IsEmail(destination) {
+		domainName := destination
+		if strings.Contains(destination, "@") {
+			domainName = helpers.EmailDomain(destination)
+		}
+		domain, err := mailcowv1.FindDomain(ctx, v.Client, transportMap.Namespace, transportMap.GetMailcowRef(), domainName)
+		if err != nil {
+			return err
+		}
+		fieldErr, err := validateDomainOwnership(ctx, v.Client, transportMap.Namespace, transportMap.GetMailcowRef(), domainName, domain, destinationPath)
+		if err != nil {
+			return err
+		}
+		if fieldErr != nil {
+			allErrs = append(allErrs, fieldErr)
+		}
+	}
+
+	var transportMaps mailcowv1.TransportMapList
+	if err := v.Client.List(ctx, &transportMaps, duplicateListOptions(transportMap.Namespace, transportMap.GetMailcowRef())...); err != nil {
+		return err
+	}
+	for _, other := range transportMaps.Items {
+		if (other.Namespace == transportMap.Namespace && other.Name == transportMap.Name) || other.GetMailcowRef() != transportMap.GetMailcowRef() {
+			continue
+		}
+		if strings.EqualFold(other.Spec.Destination, destination) {
+			allErrs = append(allErrs, field.Duplicate(destinationPath, fmt.Sprintf("the transport of the destination is already managed by TransportMap %s/%s", other.Namespace, other.Name)))
+		}
+	}
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return errors.NewInvalid(mailcowv1.GroupVersion.WithKind("TransportMap").GroupKind(), transportMap.Name, allErrs)
+}
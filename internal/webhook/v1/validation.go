@@ -0,0 +1,168 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	mailcowv1 "github.com/tarteo/mailcow-operator/api/v1"
+)
+
+// validateMailcowRef checks that the referenced Mailcow exists in the namespace, or that the referenced ClusterMailcow
+// exists and allows the namespace.
+func validateMailcowRef(ctx context.Context, c client.Reader, namespace string, ref mailcowv1.MailcowReference, fldPath *field.Path) (*field.Error, error) {
+	if ref.Name == "" {
+		return field.Required(fldPath, "mailcow or mailcowRef is required"), nil
+	}
+
+	if ref.Kind == mailcowv1.ClusterMailcowKind {
+		var clusterMailcow mailcowv1.ClusterMailcow
+		if err := c.Get(ctx, types.NamespacedName{Name: ref.Name}, &clusterMailcow); err != nil {
+			if errors.IsNotFound(err) {
+				return field.NotFound(fldPath.Child("name"), ref.Name), nil
+			}
+			return nil, err
+		}
+		allowed, err := clusterMailcow.AllowsNamespace(ctx, c, namespace)
+		if err != nil {
+			return nil, err
+		}
+		if !allowed {
+			return field.Forbidden(fldPath, fmt.Sprintf("namespace %s is not allowed to use ClusterMailcow %s", namespace, ref.Name)), nil
+		}
+		return nil, nil
+	}
+
+	var mailcow mailcowv1.Mailcow
+	if err := c.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: namespace}, &mailcow); err != nil {
+		if errors.IsNotFound(err) {
+			return field.NotFound(fldPath, ref.Name), nil
+		}
+		return nil, err
+	}
+	return nil, nil
+}
+
+// mailcowRefPath returns the path of the field the mailcow of a resource is referenced with.
+func mailcowRefPath(specPath *field.Path, ref *mailcowv1.MailcowReference) *field.Path {
+	if ref != nil {
+		return specPath.Child("mailcowRef")
+	}
+	return specPath.Child("mailcow")
+}
+
+// duplicateListOptions returns the list options to find the resources that could manage the same object in mailcow.
+// A Mailcow is only used from its own namespace, the resources of all namespaces share a ClusterMailcow.
+func duplicateListOptions(namespace string, ref mailcowv1.MailcowReference) []client.ListOption {
+	if ref.Kind == mailcowv1.ClusterMailcowKind {
+		return nil
+	}
+	return []client.ListOption{client.InNamespace(namespace)}
+}
+
+// validateSecretKeySelector checks that the selector names a secret and a key.
+func validateSecretKeySelector(selector corev1.SecretKeySelector, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if selector.Name == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("name"), "secret name is required"))
+	}
+	if selector.Key == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("key"), "secret key is required"))
+	}
+	return allErrs
+}
+
+// validateAllowedNamespaces checks the namespace names and the label selector.
+func validateAllowedNamespaces(allowed *mailcowv1.AllowedNamespaces, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if allowed == nil {
+		return allErrs
+	}
+	for i, name := range allowed.Names {
+		for _, msg := range validation.IsDNS1123Label(name) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("names").Index(i), name, msg))
+		}
+	}
+	if allowed.Selector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(allowed.Selector); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("selector"), allowed.Selector, err.Error()))
+		}
+	}
+	return allErrs
+}
+
+// validateDomainOwnership checks that the domain of a resource is owned by or shared with its namespace, domain is the
+// Domain found for it. A domain of a ClusterMailcow that no Domain manages has no owner and can't be used.
+func validateDomainOwnership(ctx context.Context, c client.Reader, namespace string, ref mailcowv1.MailcowReference, domainName string, domain *mailcowv1.Domain, fldPath *field.Path) (*field.Error, error) {
+	if ref.Kind != mailcowv1.ClusterMailcowKind {
+		return nil, nil
+	}
+	if domain == nil {
+		return field.Forbidden(fldPath, fmt.Sprintf("domain %s of ClusterMailcow %s is not managed by a Domain resource", domainName, ref.Name)), nil
+	}
+	allowed, err := domain.SharesWith(ctx, c, namespace)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return field.Forbidden(fldPath, fmt.Sprintf("domain %s is owned by Domain %s/%s and not shared with namespace %s", domainName, domain.Namespace, domain.Name, namespace)), nil
+	}
+	return nil, nil
+}
+
+// validateLocalDestRef checks that the referenced Domain or Mailbox exists and uses the same mailcow, and returns the
+// domain or email address it resolves to.
+func validateLocalDestRef(ctx context.Context, c client.Reader, namespace string, mailcow mailcowv1.MailcowReference, ref *mailcowv1.LocalDestReference, fldPath *field.Path) (string, *field.Error, error) {
+	var localDest string
+	var refMailcow mailcowv1.MailcowReference
+	name := types.NamespacedName{Name: ref.Name, Namespace: namespace}
+	switch ref.Kind {
+	case "Domain":
+		var domain mailcowv1.Domain
+		if err := c.Get(ctx, name, &domain); err != nil {
+			if errors.IsNotFound(err) {
+				return "", field.NotFound(fldPath.Child("name"), ref.Name), nil
+			}
+			return "", nil, err
+		}
+		localDest, refMailcow = domain.Spec.Domain, domain.GetMailcowRef()
+	case "Mailbox":
+		var mailbox mailcowv1.Mailbox
+		if err := c.Get(ctx, name, &mailbox); err != nil {
+			if errors.IsNotFound(err) {
+				return "", field.NotFound(fldPath.Child("name"), ref.Name), nil
+			}
+			return "", nil, err
+		}
+		localDest, refMailcow = mailbox.Spec.LocalPart+"@"+mailbox.Spec.Domain, mailbox.GetMailcowRef()
+	default:
+		return "", field.NotSupported(fldPath.Child("kind"), ref.Kind, []string{"Domain", "Mailbox"}), nil
+	}
+	if refMailcow != mailcow {
+		return "", field.Invalid(fldPath.Child("name"), ref.Name, fmt.Sprintf("%s %s uses mailcow %s", ref.Kind, ref.Name, refMailcow.String())), nil
+	}
+	return localDest, nil, nil
+}
@@ -2,12 +2,20 @@ package mailcow
 
 import (
 	"bytes"
+	"context"
+	"crypto/tls"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
 
 	securityprovider "github.com/oapi-codegen/oapi-codegen/v2/pkg/securityprovider"
+	"golang.org/x/time/rate"
 )
 
 type MailcowOKResponse struct {
@@ -25,17 +33,89 @@ type MailcowBadRequestResponse struct {
 	Type string `json:"type"`
 }
 
+// ErrorReason classifies the errors returned by the mailcow API.
+type ErrorReason string
+
+const (
+	// ErrorReasonUnauthorized means the API key was rejected.
+	ErrorReasonUnauthorized ErrorReason = "Unauthorized"
+	// ErrorReasonValidationFailed means mailcow rejected the request, retrying the same request fails again.
+	ErrorReasonValidationFailed ErrorReason = "ValidationFailed"
+	// ErrorReasonNotFound means the endpoint or object does not exist.
+	ErrorReasonNotFound ErrorReason = "NotFound"
+	// ErrorReasonRateLimited means mailcow or a proxy in front of it throttled the request.
+	ErrorReasonRateLimited ErrorReason = "RateLimited"
+	// ErrorReasonServerError means mailcow failed to handle the request.
+	ErrorReasonServerError ErrorReason = "ServerError"
+	// ErrorReasonTransport means mailcow could not be reached or the response could not be read.
+	ErrorReasonTransport ErrorReason = "Transport"
+)
+
+// Error is an error returned by the mailcow API.
+type Error struct {
+	Reason     ErrorReason
+	StatusCode int
+	Message    string
+	// RetryAfter is how long to wait before retrying a rate limited request, 0 when unknown.
+	RetryAfter time.Duration
+	// Err is the underlying error of a transport error.
+	Err error
+}
+
+func (e *Error) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("mailcow api: %s: %v", strings.ToLower(string(e.Reason)), e.Err)
+	}
+	if e.Message != "" {
+		return fmt.Sprintf("mailcow api: %s (%s)", strings.ToLower(string(e.Reason)), e.Message)
+	}
+	return fmt.Sprintf("mailcow api: %s", strings.ToLower(string(e.Reason)))
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// ReasonForError returns the reason of a mailcow API error, or an empty reason for other errors.
+func ReasonForError(err error) ErrorReason {
+	var mailcowErr *Error
+	if errors.As(err, &mailcowErr) {
+		return mailcowErr.Reason
+	}
+	return ""
+}
+
+// RetryAfterForError returns how long to wait before retrying a rate limited request, 0 when unknown.
+func RetryAfterForError(err error) time.Duration {
+	var mailcowErr *Error
+	if errors.As(err, &mailcowErr) {
+		return mailcowErr.RetryAfter
+	}
+	return 0
+}
+
 func checkMailcowResponse(response *http.Response, body []byte) error {
-	if response.StatusCode == http.StatusUnauthorized {
-		return fmt.Errorf("mailcow api: unauthorized")
+	switch {
+	case response.StatusCode == http.StatusUnauthorized || response.StatusCode == http.StatusForbidden:
+		return &Error{Reason: ErrorReasonUnauthorized, StatusCode: response.StatusCode}
+	case response.StatusCode == http.StatusNotFound:
+		return &Error{Reason: ErrorReasonNotFound, StatusCode: response.StatusCode, Message: response.Request.URL.Path}
+	case response.StatusCode == http.StatusTooManyRequests:
+		mailcowErr := &Error{Reason: ErrorReasonRateLimited, StatusCode: response.StatusCode}
+		if seconds, err := strconv.Atoi(response.Header.Get("Retry-After")); err == nil {
+			mailcowErr.RetryAfter = time.Duration(seconds) * time.Second
+		}
+		return mailcowErr
+	case response.StatusCode >= http.StatusInternalServerError:
+		return &Error{Reason: ErrorReasonServerError, StatusCode: response.StatusCode, Message: response.Status}
 	}
 
 	if response.StatusCode == http.StatusBadRequest {
 		var badRequest MailcowBadRequestResponse
 		if err := json.Unmarshal(body, &badRequest); err != nil {
-			return fmt.Errorf("mailcow api: failed to parse response (%s)", string(body))
+			return &Error{Reason: ErrorReasonValidationFailed, StatusCode: response.StatusCode, Message: string(body)}
 		}
-		return fmt.Errorf("mailcow api: bad request (%s)", badRequest.Msg)
+		return &Error{Reason: ErrorReasonValidationFailed, StatusCode: response.StatusCode, Message: badRequest.Msg}
 	}
 
 	// Unable to parse response if it's a get request that returns an object e.g. /get/domain/{id}
@@ -47,7 +127,7 @@ func checkMailcowResponse(response *http.Response, body []byte) error {
 	if err := json.Unmarshal(body, &responses); err == nil {
 		for _, r := range responses {
 			if r.Type == "danger" {
-				return fmt.Errorf("mailcow api: %s", r.Msg)
+				return &Error{Reason: ErrorReasonValidationFailed, StatusCode: response.StatusCode, Message: r.Msg}
 			}
 		}
 	}
@@ -56,7 +136,7 @@ func checkMailcowResponse(response *http.Response, body []byte) error {
 	if err := json.Unmarshal(body, &responseWithMessageArrays); err == nil {
 		for _, r := range responseWithMessageArrays {
 			if r.Type == "danger" {
-				return fmt.Errorf("mailcow api: %s", fmt.Sprintf("%v", r.Msg))
+				return &Error{Reason: ErrorReasonValidationFailed, StatusCode: response.StatusCode, Message: fmt.Sprintf("%v", r.Msg)}
 			}
 		}
 	}
@@ -64,18 +144,56 @@ func checkMailcowResponse(response *http.Response, body []byte) error {
 	return nil
 }
 
+// RequestObserver is called after every request with the status code of the response, 0 when there is none,
+// the time mailcow took to respond and the error of the request.
+type RequestObserver func(req *http.Request, statusCode int, duration time.Duration, err error)
+
 type MailcowRequestDoer struct {
 	Client *http.Client
+	// Limiter limits the rate of requests, nil means unlimited.
+	Limiter *rate.Limiter
+	// Slots caps the number of concurrent requests, nil means unlimited.
+	Slots chan struct{}
+	// Observer is called after every request, nil means requests are not observed.
+	Observer RequestObserver
 }
 
 func (d *MailcowRequestDoer) Do(req *http.Request) (*http.Response, error) {
+	if d.Limiter != nil {
+		if err := d.Limiter.Wait(req.Context()); err != nil {
+			return nil, err
+		}
+	}
+	if d.Slots != nil {
+		select {
+		case d.Slots <- struct{}{}:
+			defer func() { <-d.Slots }()
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+	}
+
+	// Time spent waiting for the limits above is not part of the observed duration
+	start := time.Now()
+	response, err := d.do(req)
+	if d.Observer != nil {
+		statusCode := 0
+		if response != nil {
+			statusCode = response.StatusCode
+		}
+		d.Observer(req, statusCode, time.Since(start), err)
+	}
+	return response, err
+}
+
+func (d *MailcowRequestDoer) do(req *http.Request) (*http.Response, error) {
 	response, err := d.Client.Do(req)
 	if err != nil {
-		return response, err
+		return response, &Error{Reason: ErrorReasonTransport, Err: err}
 	}
 	body, err := io.ReadAll(response.Body)
 	if err != nil {
-		return nil, err
+		return nil, &Error{Reason: ErrorReasonTransport, Err: err}
 	}
 	err = response.Body.Close()
 	if err != nil {
@@ -86,12 +204,273 @@ func (d *MailcowRequestDoer) Do(req *http.Request) (*http.Response, error) {
 	return response, err
 }
 
-func NewCustomClientWithResponses(endpoint string, apiKey string) (*ClientWithResponses, error) {
+// EndpointForPath returns the endpoint of a request path without the ids, e.g. /api/v1/get/mailbox for
+// /api/v1/get/mailbox/john@example.com, so it can be used as a metric label.
+func EndpointForPath(path string) string {
+	// Drop the path of a mailcow served under a prefix
+	if i := strings.Index(path, "/api/"); i > 0 {
+		path = path[i:]
+	}
+	parts := strings.SplitN(strings.TrimPrefix(path, "/"), "/", 5)
+	if len(parts) > 4 {
+		parts = parts[:4]
+	}
+	return "/" + strings.Join(parts, "/")
+}
+
+// CustomClientOption configures the client created by NewCustomClientWithResponses.
+type CustomClientOption func(*MailcowRequestDoer)
+
+// WithRateLimit limits the client to requestsPerSecond with bursts of burst requests, 0 disables the limit.
+func WithRateLimit(requestsPerSecond int, burst int) CustomClientOption {
+	return func(d *MailcowRequestDoer) {
+		if requestsPerSecond <= 0 {
+			return
+		}
+		d.Limiter = rate.NewLimiter(rate.Limit(requestsPerSecond), max(burst, 1))
+	}
+}
+
+// WithMaxConcurrentRequests caps the number of requests in flight, 0 disables the cap.
+func WithMaxConcurrentRequests(n int) CustomClientOption {
+	return func(d *MailcowRequestDoer) {
+		if n <= 0 {
+			return
+		}
+		d.Slots = make(chan struct{}, n)
+		d.Client.Transport.(*http.Transport).MaxIdleConnsPerHost = n
+	}
+}
+
+// WithRequestObserver calls observer after every request, e.g. to record metrics.
+func WithRequestObserver(observer RequestObserver) CustomClientOption {
+	return func(d *MailcowRequestDoer) {
+		d.Observer = observer
+	}
+}
+
+// WithTimeout limits the time of a single request, including reading the response, 0 disables the timeout.
+func WithTimeout(timeout time.Duration) CustomClientOption {
+	return func(d *MailcowRequestDoer) {
+		d.Client.Timeout = timeout
+	}
+}
+
+// WithTLSConfig sets the TLS configuration used to connect to mailcow.
+func WithTLSConfig(config *tls.Config) CustomClientOption {
+	return func(d *MailcowRequestDoer) {
+		d.Client.Transport.(*http.Transport).TLSClientConfig = config
+	}
+}
+
+// WithProxy sends the requests through the proxy, by default the proxy is taken from the environment.
+func WithProxy(proxy *url.URL) CustomClientOption {
+	return func(d *MailcowRequestDoer) {
+		d.Client.Transport.(*http.Transport).Proxy = http.ProxyURL(proxy)
+	}
+}
+
+func NewCustomClientWithResponses(endpoint string, apiKey string, opts ...CustomClientOption) (*ClientWithResponses, error) {
 	apiKeyAuth, err := securityprovider.NewSecurityProviderApiKey("header", "X-API-Key", apiKey)
 	if err != nil {
 		return nil, err
 	}
-	requestDoer := &MailcowRequestDoer{Client: &http.Client{}}
+	// Every client has its own connection pool, which is reused for as long as the client is
+	requestDoer := &MailcowRequestDoer{Client: &http.Client{Transport: http.DefaultTransport.(*http.Transport).Clone()}}
+	for _, opt := range opts {
+		opt(requestDoer)
+	}
 	client, err := NewClientWithResponses(endpoint, WithRequestEditorFn(apiKeyAuth.Intercept), WithHTTPClient(requestDoer))
 	return client, err
 }
+
+// CloseIdleConnections closes the idle connections of a client created by NewCustomClientWithResponses.
+func (c *ClientWithResponses) CloseIdleConnections() {
+	if client, ok := c.ClientInterface.(*Client); ok {
+		if doer, ok := client.Client.(*MailcowRequestDoer); ok {
+			doer.Client.CloseIdleConnections()
+		}
+	}
+}
+
+// decodeList decodes a list response.
+// When there are no entries mailcow returns an empty object instead of an empty array, that is returned as an empty list.
+func decodeList[T any](response *http.Response, err error) ([]T, error) {
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []T
+	if err := json.Unmarshal(body, &items); err != nil {
+		var empty map[string]any
+		if json.Unmarshal(body, &empty) == nil && len(empty) == 0 {
+			return []T{}, nil
+		}
+		return nil, &Error{Reason: ErrorReasonServerError, StatusCode: response.StatusCode, Message: fmt.Sprintf("failed to parse list response (%s)", string(body))}
+	}
+	return items, nil
+}
+
+// ListDomains returns all domains.
+func (c *ClientWithResponses) ListDomains(ctx context.Context) ([]Domain, error) {
+	return decodeList[Domain](c.GetDomains(ctx, "all", nil))
+}
+
+// ListMailboxes returns all mailboxes.
+func (c *ClientWithResponses) ListMailboxes(ctx context.Context) ([]Mailbox, error) {
+	return decodeList[Mailbox](c.GetMailboxes(ctx, "all", nil))
+}
+
+// ListAliases returns all aliases.
+func (c *ClientWithResponses) ListAliases(ctx context.Context) ([]Alias, error) {
+	return decodeList[Alias](c.GetAliases(ctx, "all", nil))
+}
+
+// ListDomainAdmins returns all domain admins.
+func (c *ClientWithResponses) ListDomainAdmins(ctx context.Context) ([]DomainAdmin, error) {
+	return decodeList[DomainAdmin](c.GetDomainAdmins(ctx))
+}
+
+// ListSyncJobs returns all sync jobs.
+func (c *ClientWithResponses) ListSyncJobs(ctx context.Context) ([]SyncJob, error) {
+	return decodeList[SyncJob](c.GetSyncJobs(ctx))
+}
+
+// AppPassword is an app password of a mailbox, mailcow returns active as a number or a string depending on its version.
+type AppPassword struct {
+	Active  json.Number `json:"active,omitempty"`
+	Id      *int        `json:"id,omitempty"`
+	Mailbox *string     `json:"mailbox,omitempty"`
+	Name    *string     `json:"name,omitempty"`
+}
+
+// ListAppPasswords returns the app passwords of a mailbox.
+func (c *ClientWithResponses) ListAppPasswords(ctx context.Context, mailbox string) ([]AppPassword, error) {
+	return decodeList[AppPassword](c.GetAppPassword(ctx, GetAppPasswordParamsMailbox(mailbox), nil))
+}
+
+// BCCMap is a BCC map, mailcow returns active as a number or a string depending on its version.
+type BCCMap struct {
+	Active    json.Number `json:"active,omitempty"`
+	BccDest   *string     `json:"bcc_dest,omitempty"`
+	Domain    *string     `json:"domain,omitempty"`
+	Id        *int        `json:"id,omitempty"`
+	LocalDest *string     `json:"local_dest,omitempty"`
+	Type      *string     `json:"type,omitempty"`
+}
+
+// ListBCCMaps returns all BCC maps.
+func (c *ClientWithResponses) ListBCCMaps(ctx context.Context) ([]BCCMap, error) {
+	return decodeList[BCCMap](c.GetBCCMap(ctx, "all", nil))
+}
+
+// RecipientMap is a recipient map, mailcow returns active as a number or a string depending on its version.
+type RecipientMap struct {
+	Active          json.Number `json:"active,omitempty"`
+	Id              *int        `json:"id,omitempty"`
+	RecipientMapNew *string     `json:"recipient_map_new,omitempty"`
+	RecipientMapOld *string     `json:"recipient_map_old,omitempty"`
+}
+
+// ListRecipientMaps returns all recipient maps.
+func (c *ClientWithResponses) ListRecipientMaps(ctx context.Context) ([]RecipientMap, error) {
+	return decodeList[RecipientMap](c.GetRecipientMap(ctx, "all", nil))
+}
+
+// TLSPolicyMap is a TLS policy map, mailcow returns active as a number or a string depending on its version.
+type TLSPolicyMap struct {
+	Active     json.Number `json:"active,omitempty"`
+	Dest       *string     `json:"dest,omitempty"`
+	Id         *int        `json:"id,omitempty"`
+	Parameters *string     `json:"parameters,omitempty"`
+	Policy     *string     `json:"policy,omitempty"`
+}
+
+// ListTLSPolicyMaps returns all TLS policy maps.
+func (c *ClientWithResponses) ListTLSPolicyMaps(ctx context.Context) ([]TLSPolicyMap, error) {
+	return decodeList[TLSPolicyMap](c.GetTLSPolicyMap(ctx, "all", nil))
+}
+
+// Relayhost is a sender-dependent transport, mailcow returns active as a number or a string depending on its version.
+type Relayhost struct {
+	Active        json.Number `json:"active,omitempty"`
+	Hostname      *string     `json:"hostname,omitempty"`
+	Id            *int        `json:"id,omitempty"`
+	Password      *string     `json:"password,omitempty"`
+	UsedByDomains *string     `json:"used_by_domains,omitempty"`
+	Username      *string     `json:"username,omitempty"`
+}
+
+// ListRelayhosts returns all sender-dependent transports.
+func (c *ClientWithResponses) ListRelayhosts(ctx context.Context) ([]Relayhost, error) {
+	return decodeList[Relayhost](c.GetSenderDependentTransports(ctx, "all", nil))
+}
+
+// Transport is a transport map, mailcow returns active as a number or a string depending on its version.
+type Transport struct {
+	Active      json.Number `json:"active,omitempty"`
+	Destination *string     `json:"destination,omitempty"`
+	Id          *int        `json:"id,omitempty"`
+	Nexthop     *string     `json:"nexthop,omitempty"`
+	Password    *string     `json:"password,omitempty"`
+	Username    *string     `json:"username,omitempty"`
+}
+
+// ListTransports returns all transport maps.
+func (c *ClientWithResponses) ListTransports(ctx context.Context) ([]Transport, error) {
+	return decodeList[Transport](c.GetTransportMaps(ctx, "all", nil))
+}
+
+// DomainPolicy is an entry of the allow or deny list of a domain.
+type DomainPolicy struct {
+	Object *string `json:"object,omitempty"`
+	Prefid *int    `json:"prefid,omitempty"`
+	Value  *string `json:"value,omitempty"`
+}
+
+// ListDomainPolicies returns the entries of the allow (wl) or deny (bl) list of a domain.
+func (c *ClientWithResponses) ListDomainPolicies(ctx context.Context, domain string, list CreateDomainPolicyJSONBodyObjectList) ([]DomainPolicy, error) {
+	if list == Bl {
+		return decodeList[DomainPolicy](c.ListBlacklistDomainPolicy(ctx, domain, nil))
+	}
+	return decodeList[DomainPolicy](c.ListWhitelistDomainPolicy(ctx, domain, nil))
+}
+
+// QueueItem is a message in the mail queue.
+type QueueItem struct {
+	ArrivalTime *int      `json:"arrival_time,omitempty"`
+	MessageSize *int      `json:"message_size,omitempty"`
+	QueueId     *string   `json:"queue_id,omitempty"`
+	QueueName   *string   `json:"queue_name,omitempty"`
+	Recipients  *[]string `json:"recipients,omitempty"`
+	Sender      *string   `json:"sender,omitempty"`
+}
+
+// QuarantineItem is a message in the quarantine.
+type QuarantineItem struct {
+	Created   *int     `json:"created,omitempty"`
+	Id        *int     `json:"id,omitempty"`
+	Notified  *int     `json:"notified,omitempty"`
+	Qid       *string  `json:"qid,omitempty"`
+	Rcpt      *string  `json:"rcpt,omitempty"`
+	Score     *float32 `json:"score,omitempty"`
+	Sender    *string  `json:"sender,omitempty"`
+	Subject   *string  `json:"subject,omitempty"`
+	VirusFlag *int     `json:"virus_flag,omitempty"`
+}
+
+// ListQueue returns the messages in the mail queue.
+func (c *ClientWithResponses) ListQueue(ctx context.Context) ([]QueueItem, error) {
+	return decodeList[QueueItem](c.GetQueue(ctx))
+}
+
+// ListQuarantine returns the messages in the quarantine.
+func (c *ClientWithResponses) ListQuarantine(ctx context.Context) ([]QuarantineItem, error) {
+	return decodeList[QuarantineItem](c.GetMailsInQuarantine(ctx))
+}
@@ -43,6 +43,16 @@ const (
 	TLS   EditSyncJobAttrEnc1 = "TLS"
 )
 
+// Defines values for CreateAppPasswordJSONBodyProtocols.
+const (
+	DavAccess   CreateAppPasswordJSONBodyProtocols = "dav_access"
+	EasAccess   CreateAppPasswordJSONBodyProtocols = "eas_access"
+	ImapAccess  CreateAppPasswordJSONBodyProtocols = "imap_access"
+	Pop3Access  CreateAppPasswordJSONBodyProtocols = "pop3_access"
+	SieveAccess CreateAppPasswordJSONBodyProtocols = "sieve_access"
+	SmtpAccess  CreateAppPasswordJSONBodyProtocols = "smtp_access"
+)
+
 // Defines values for CreateDomainJSONBodyRlFrame.
 const (
 	CreateDomainJSONBodyRlFrameD CreateDomainJSONBodyRlFrame = "d"
@@ -80,7 +90,8 @@ const (
 
 // Defines values for CreateTLSPolicyMapJSONBodyPolicy.
 const (
-	Dane        CreateTLSPolicyMapJSONBodyPolicy = "'dane"
+	Dane        CreateTLSPolicyMapJSONBodyPolicy = "dane"
+	DaneOnly    CreateTLSPolicyMapJSONBodyPolicy = "dane-only"
 	Encrypt     CreateTLSPolicyMapJSONBodyPolicy = "encrypt"
 	Fingerprint CreateTLSPolicyMapJSONBodyPolicy = "fingerprint"
 	May         CreateTLSPolicyMapJSONBodyPolicy = "may"
@@ -169,6 +180,57 @@ const (
 	N5  GetTransportMapsParamsId = "5"
 )
 
+// Alias defines model for Alias.
+type Alias struct {
+	Active          *int    `json:"active,omitempty"`
+	Address         *string `json:"address,omitempty"`
+	Created         *string `json:"created,omitempty"`
+	Domain          *string `json:"domain,omitempty"`
+	Goto            *string `json:"goto,omitempty"`
+	Id              *int    `json:"id,omitempty"`
+	InPrimaryDomain *string `json:"in_primary_domain,omitempty"`
+	IsCatchAll      *int    `json:"is_catch_all,omitempty"`
+	Modified        *string `json:"modified"`
+	PrivateComment  *string `json:"private_comment"`
+	PublicComment   *string `json:"public_comment"`
+}
+
+// Domain defines model for Domain.
+type Domain struct {
+	Active                 *int      `json:"active,omitempty"`
+	AliasesInDomain        *int      `json:"aliases_in_domain,omitempty"`
+	AliasesLeft            *int      `json:"aliases_left,omitempty"`
+	Backupmx               *int      `json:"backupmx,omitempty"`
+	BytesTotal             *int      `json:"bytes_total,omitempty"`
+	DefNewMailboxQuota     *int      `json:"def_new_mailbox_quota,omitempty"`
+	DefQuotaForMbox        *int      `json:"def_quota_for_mbox,omitempty"`
+	Description            *string   `json:"description,omitempty"`
+	DomainName             *string   `json:"domain_name,omitempty"`
+	Gal                    *int      `json:"gal,omitempty"`
+	MaxNewMailboxQuota     *int      `json:"max_new_mailbox_quota,omitempty"`
+	MaxNumAliasesForDomain *int      `json:"max_num_aliases_for_domain,omitempty"`
+	MaxNumMboxesForDomain  *int      `json:"max_num_mboxes_for_domain,omitempty"`
+	MaxQuotaForDomain      *int      `json:"max_quota_for_domain,omitempty"`
+	MaxQuotaForMbox        *int      `json:"max_quota_for_mbox,omitempty"`
+	MboxesInDomain         *int      `json:"mboxes_in_domain,omitempty"`
+	MboxesLeft             *int      `json:"mboxes_left,omitempty"`
+	MsgsTotal              *int      `json:"msgs_total,omitempty"`
+	QuotaUsedInDomain      *string   `json:"quota_used_in_domain,omitempty"`
+	RelayAllRecipients     *float32  `json:"relay_all_recipients,omitempty"`
+	Relayhost              *string   `json:"relayhost,omitempty"`
+	Tags                   *[]string `json:"tags,omitempty"`
+}
+
+// DomainAdmin defines model for DomainAdmin.
+type DomainAdmin struct {
+	Active            *int      `json:"active,omitempty"`
+	Created           *string   `json:"created,omitempty"`
+	SelectedDomains   *[]string `json:"selected_domains,omitempty"`
+	TfaActive         *int      `json:"tfa_active,omitempty"`
+	UnselectedDomains *[]string `json:"unselected_domains,omitempty"`
+	Username          *string   `json:"username,omitempty"`
+}
+
 // EditAliasAttr defines model for EditAliasAttr.
 type EditAliasAttr struct {
 	// Active is alias active or not
@@ -195,6 +257,39 @@ type EditAliasAttr struct {
 	SogoVisible *bool `json:"sogo_visible,omitempty"`
 }
 
+// EditAppPasswordAttr defines model for EditAppPasswordAttr.
+type EditAppPasswordAttr struct {
+	// Active is app password active or not
+	Active *bool `json:"active,omitempty"`
+
+	// AppName name of your app password
+	AppName *string `json:"app_name,omitempty"`
+
+	// AppPasswd your app password
+	AppPasswd *string `json:"app_passwd,omitempty"`
+
+	// AppPasswd2 your app password
+	AppPasswd2 *string `json:"app_passwd2,omitempty"`
+
+	// Protocols protocols the app password can be used for
+	Protocols *[]string `json:"protocols,omitempty"`
+}
+
+// EditBCCMapAttr defines model for EditBCCMapAttr.
+type EditBCCMapAttr struct {
+	// Active is bcc map active or not
+	Active *bool `json:"active,omitempty"`
+
+	// BccDest the email address where all mails should be send to
+	BccDest *string `json:"bcc_dest,omitempty"`
+
+	// LocalDest the domain or email address which emails should be forwarded
+	LocalDest *string `json:"local_dest,omitempty"`
+
+	// Type the type of bcc map can be `sender` or `recipient`
+	Type *string `json:"type,omitempty"`
+}
+
 // EditCorsAttr defines model for EditCorsAttr.
 type EditCorsAttr struct {
 	AllowedMethods *[]string `json:"allowed_methods,omitempty"`
@@ -412,6 +507,33 @@ type EditRatelimitMailboxAttr struct {
 	RlValue *int `json:"rl_value,omitempty"`
 }
 
+// EditRecipientMapAttr defines model for EditRecipientMapAttr.
+type EditRecipientMapAttr struct {
+	// Active is recipient map active or not
+	Active *bool `json:"active,omitempty"`
+
+	// RecipientMapNew the email address that should receive the mails
+	RecipientMapNew *string `json:"recipient_map_new,omitempty"`
+
+	// RecipientMapOld the email address or domain which should be rewritten
+	RecipientMapOld *string `json:"recipient_map_old,omitempty"`
+}
+
+// EditRelayhostAttr defines model for EditRelayhostAttr.
+type EditRelayhostAttr struct {
+	// Active is relayhost active or not
+	Active *bool `json:"active,omitempty"`
+
+	// Hostname the hostname of the smtp server with port
+	Hostname *string `json:"hostname,omitempty"`
+
+	// Password the password for the smtp user
+	Password *string `json:"password,omitempty"`
+
+	// Username the username used to authenticate
+	Username *string `json:"username,omitempty"`
+}
+
 // EditSyncJobAttr defines model for EditSyncJobAttr.
 type EditSyncJobAttr struct {
 	// Active Is sync job active
@@ -478,12 +600,109 @@ type EditSyncJobAttr struct {
 // EditSyncJobAttrEnc1 Encryption
 type EditSyncJobAttrEnc1 string
 
+// EditTLSPolicyMapAttr defines model for EditTLSPolicyMapAttr.
+type EditTLSPolicyMapAttr struct {
+	// Active is tls policy map active or not
+	Active *bool `json:"active,omitempty"`
+
+	// Dest the target domain or email address
+	Dest *string `json:"dest,omitempty"`
+
+	// Parameters custom parameters you find out more about them [here](http://www.postfix.org/postconf.5.html#smtp_tls_policy_maps)
+	Parameters *string `json:"parameters,omitempty"`
+
+	// Policy the policy
+	Policy *string `json:"policy,omitempty"`
+}
+
+// EditTransportAttr defines model for EditTransportAttr.
+type EditTransportAttr struct {
+	// Active is transport map active or not
+	Active *bool `json:"active,omitempty"`
+
+	// Destination the domain or email address the transport is used for
+	Destination *string `json:"destination,omitempty"`
+
+	// Nexthop the hostname of the smtp server with port
+	Nexthop *string `json:"nexthop,omitempty"`
+
+	// Password the password for the smtp user
+	Password *string `json:"password,omitempty"`
+
+	// Username the username used to authenticate
+	Username *string `json:"username,omitempty"`
+}
+
 // EditUserAclAttr defines model for EditUserAclAttr.
 type EditUserAclAttr struct {
 	// UserAcl contains a list of active user acls
 	UserAcl *map[string]interface{} `json:"user_acl,omitempty"`
 }
 
+// Mailbox defines model for Mailbox.
+type Mailbox struct {
+	Active     *int `json:"active,omitempty"`
+	Attributes *struct {
+		ForcePwUpdate          *string `json:"force_pw_update,omitempty"`
+		MailboxFormat          *string `json:"mailbox_format,omitempty"`
+		QuarantineNotification *string `json:"quarantine_notification,omitempty"`
+		SogoAccess             *string `json:"sogo_access,omitempty"`
+		TlsEnforceIn           *string `json:"tls_enforce_in,omitempty"`
+		TlsEnforceOut          *string `json:"tls_enforce_out,omitempty"`
+	} `json:"attributes,omitempty"`
+	Domain        *string   `json:"domain,omitempty"`
+	IsRelayed     *int      `json:"is_relayed,omitempty"`
+	LastImapLogin *int      `json:"last_imap_login,omitempty"`
+	LastPop3Login *int      `json:"last_pop3_login,omitempty"`
+	LastSmtpLogin *int      `json:"last_smtp_login,omitempty"`
+	LocalPart     *string   `json:"local_part,omitempty"`
+	MaxNewQuota   *int      `json:"max_new_quota,omitempty"`
+	Messages      *int      `json:"messages,omitempty"`
+	Name          *string   `json:"name,omitempty"`
+	PercentClass  *string   `json:"percent_class,omitempty"`
+	Quota         *int      `json:"quota,omitempty"`
+	QuotaUsed     *int      `json:"quota_used,omitempty"`
+	SpamAliases   *int      `json:"spam_aliases,omitempty"`
+	Tags          *[]string `json:"tags,omitempty"`
+	Username      *string   `json:"username,omitempty"`
+}
+
+// SyncJob defines model for SyncJob.
+type SyncJob struct {
+	Active              *string `json:"active,omitempty"`
+	Authmd51            *int    `json:"authmd51,omitempty"`
+	Authmech1           *string `json:"authmech1,omitempty"`
+	Automap             *int    `json:"automap,omitempty"`
+	Created             *string `json:"created,omitempty"`
+	CustomParams        *string `json:"custom_params,omitempty"`
+	Delete1             *int    `json:"delete1,omitempty"`
+	Delete2             *int    `json:"delete2,omitempty"`
+	Delete2duplicates   *int    `json:"delete2duplicates,omitempty"`
+	Domain2             *string `json:"domain2,omitempty"`
+	Enc1                *string `json:"enc1,omitempty"`
+	Exclude             *string `json:"exclude,omitempty"`
+	ExitStatus          *string `json:"exit_status,omitempty"`
+	Host1               *string `json:"host1,omitempty"`
+	Id                  *int    `json:"id,omitempty"`
+	IsRunning           *int    `json:"is_running,omitempty"`
+	LastRun             *string `json:"last_run,omitempty"`
+	Log                 *string `json:"log,omitempty"`
+	Maxage              *int    `json:"maxage,omitempty"`
+	Maxbytespersecond   *string `json:"maxbytespersecond,omitempty"`
+	MinsInterval        *string `json:"mins_interval,omitempty"`
+	Modified            *string `json:"modified,omitempty"`
+	Port1               *int    `json:"port1,omitempty"`
+	Regextrans2         *string `json:"regextrans2,omitempty"`
+	Skipcrossduplicates *int    `json:"skipcrossduplicates,omitempty"`
+	Subfolder2          *string `json:"subfolder2,omitempty"`
+	Subscribeall        *int    `json:"subscribeall,omitempty"`
+	Success             *int    `json:"success,omitempty"`
+	Timeout1            *int    `json:"timeout1,omitempty"`
+	Timeout2            *int    `json:"timeout2,omitempty"`
+	User1               *string `json:"user1,omitempty"`
+	User2               *string `json:"user2,omitempty"`
+}
+
 // Unauthorized defines model for Unauthorized.
 type Unauthorized struct {
 	Msg  string `json:"msg"`
@@ -528,10 +747,16 @@ type CreateAppPasswordJSONBody struct {
 	// AppPasswd2 your app password
 	AppPasswd2 *string `json:"app_passwd2,omitempty"`
 
+	// Protocols protocols the app password can be used for
+	Protocols *[]CreateAppPasswordJSONBodyProtocols `json:"protocols,omitempty"`
+
 	// Username mailbox for which the app password should be created
 	Username *string `json:"username,omitempty"`
 }
 
+// CreateAppPasswordJSONBodyProtocols defines parameters for CreateAppPassword.
+type CreateAppPasswordJSONBodyProtocols string
+
 // CreateBCCMapJSONBody defines parameters for CreateBCCMap.
 type CreateBCCMapJSONBody struct {
 	// Active 1 for a active user account 0 for a disabled user account
@@ -568,6 +793,21 @@ type DuplicateDKIMKeyJSONBody struct {
 	ToDomain *string `json:"to_domain,omitempty"`
 }
 
+// ImportDKIMKeyJSONBody defines parameters for ImportDKIMKey.
+type ImportDKIMKeyJSONBody struct {
+	// DkimSelector the DKIM selector default dkim
+	DkimSelector *string `json:"dkim_selector,omitempty"`
+
+	// Domain the domain the dkim key is imported for
+	Domain *string `json:"domain,omitempty"`
+
+	// OverwriteExisting replace the existing dkim key of the domain (0 or 1)
+	OverwriteExisting *int `json:"overwrite_existing,omitempty"`
+
+	// PrivateKeyFile the private key in PEM format
+	PrivateKeyFile *string `json:"private_key_file,omitempty"`
+}
+
 // CreateDomainJSONBody defines parameters for CreateDomain.
 type CreateDomainJSONBody struct {
 	// Active is domain active or not
@@ -784,7 +1024,7 @@ type CreateSyncJobJSONBody struct {
 	// Exclude exclude objects (regex)
 	Exclude *string `json:"exclude,omitempty"`
 
-	// Host1 the smtp server where mails should be synced from
+	// Host1 the imap server where mails should be synced from
 	Host1 *string `json:"host1,omitempty"`
 
 	// Maxage only sync messages up to this age in days
@@ -793,16 +1033,13 @@ type CreateSyncJobJSONBody struct {
 	// Maxbytespersecond max speed transfer limit for the sync
 	Maxbytespersecond *float32 `json:"maxbytespersecond,omitempty"`
 
-	// MinsInternal the interval in which messages should be syned
-	MinsInternal *float32 `json:"mins_internal,omitempty"`
-
-	// Parameters your local mailcow mailbox
-	Parameters *string `json:"parameters,omitempty"`
+	// MinsInterval the interval in which messages should be synced
+	MinsInterval *float32 `json:"mins_interval,omitempty"`
 
-	// Password the password of the mailbox
-	Password *string `json:"password,omitempty"`
+	// Password1 the password on the source mail server
+	Password1 *string `json:"password1,omitempty"`
 
-	// Port1 the smtp port of the target mail server
+	// Port1 the imap port of the source mail server
 	Port1 *string `json:"port1,omitempty"`
 
 	// Skipcrossduplicates skip duplicate messages across folders (first come, first serve) (--skipcrossduplicates)
@@ -819,6 +1056,12 @@ type CreateSyncJobJSONBody struct {
 
 	// Timeout2 timeout for connection to local host
 	Timeout2 *float32 `json:"timeout2,omitempty"`
+
+	// User1 the username on the source mail server
+	User1 *string `json:"user1,omitempty"`
+
+	// Username your local mailcow mailbox
+	Username *string `json:"username,omitempty"`
 }
 
 // CreateTimeLimitedAliasJSONBody defines parameters for CreateTimeLimitedAlias.
@@ -866,16 +1109,10 @@ type CreateTransportMapsJSONBody struct {
 type DeleteAliasJSONBody = []string
 
 // DeleteAppPasswordJSONBody defines parameters for DeleteAppPassword.
-type DeleteAppPasswordJSONBody struct {
-	// Items contains list of app passwords you want to delete
-	Items *map[string]interface{} `json:"items,omitempty"`
-}
+type DeleteAppPasswordJSONBody = []string
 
 // DeleteBCCMapJSONBody defines parameters for DeleteBCCMap.
-type DeleteBCCMapJSONBody struct {
-	// Items contains list of bcc maps you want to delete
-	Items *map[string]interface{} `json:"items,omitempty"`
-}
+type DeleteBCCMapJSONBody = []string
 
 // DeleteDKIMKeyJSONBody defines parameters for DeleteDKIMKey.
 type DeleteDKIMKeyJSONBody = []string
@@ -887,10 +1124,7 @@ type DeleteDomainJSONBody = []string
 type DeleteDomainAdminJSONBody = []string
 
 // DeleteDomainPolicyJSONBody defines parameters for DeleteDomainPolicy.
-type DeleteDomainPolicyJSONBody struct {
-	// Items contains list of domain policys you want to delete
-	Items *map[string]interface{} `json:"items,omitempty"`
-}
+type DeleteDomainPolicyJSONBody = []string
 
 // DeleteDomainTagsJSONBody defines parameters for DeleteDomainTags.
 type DeleteDomainTagsJSONBody struct {
@@ -932,16 +1166,10 @@ type DeleteMailsInQuarantineJSONBody struct {
 }
 
 // DeleteRecipientMapJSONBody defines parameters for DeleteRecipientMap.
-type DeleteRecipientMapJSONBody struct {
-	// Items contains list of recipient maps you want to delete
-	Items *map[string]interface{} `json:"items,omitempty"`
-}
+type DeleteRecipientMapJSONBody = []string
 
 // DeleteSenderDependentTransportsJSONBody defines parameters for DeleteSenderDependentTransports.
-type DeleteSenderDependentTransportsJSONBody struct {
-	// Items contains list of Sender-Dependent Transport you want to delete
-	Items *map[string]interface{} `json:"items,omitempty"`
-}
+type DeleteSenderDependentTransportsJSONBody = []string
 
 // DeleteResourcesJSONBody defines parameters for DeleteResources.
 type DeleteResourcesJSONBody struct {
@@ -950,22 +1178,13 @@ type DeleteResourcesJSONBody struct {
 }
 
 // DeleteSyncJobJSONBody defines parameters for DeleteSyncJob.
-type DeleteSyncJobJSONBody struct {
-	// Items contains list of aliases you want to delete
-	Items *map[string]interface{} `json:"items,omitempty"`
-}
+type DeleteSyncJobJSONBody = []string
 
 // DeleteTLSPolicyMapJSONBody defines parameters for DeleteTLSPolicyMap.
-type DeleteTLSPolicyMapJSONBody struct {
-	// Items contains list of tls policy maps you want to delete
-	Items *map[string]interface{} `json:"items,omitempty"`
-}
+type DeleteTLSPolicyMapJSONBody = []string
 
 // DeleteTransportMapsJSONBody defines parameters for DeleteTransportMaps.
-type DeleteTransportMapsJSONBody struct {
-	// Items contains list of transport maps you want to delete
-	Items *map[string]interface{} `json:"items,omitempty"`
-}
+type DeleteTransportMapsJSONBody = []string
 
 // UpdateAliasJSONBody defines parameters for UpdateAlias.
 type UpdateAliasJSONBody struct {
@@ -975,6 +1194,22 @@ type UpdateAliasJSONBody struct {
 	Items *[]string `json:"items,omitempty"`
 }
 
+// UpdateAppPasswordJSONBody defines parameters for UpdateAppPassword.
+type UpdateAppPasswordJSONBody struct {
+	Attr *EditAppPasswordAttr `json:"attr,omitempty"`
+
+	// Items contains list of app passwords you want update
+	Items *[]string `json:"items,omitempty"`
+}
+
+// UpdateBCCMapJSONBody defines parameters for UpdateBCCMap.
+type UpdateBCCMapJSONBody struct {
+	Attr *EditBCCMapAttr `json:"attr,omitempty"`
+
+	// Items contains list of bcc maps you want update
+	Items *[]string `json:"items,omitempty"`
+}
+
 // EditCrossOriginResourceSharingCORSSettingsJSONBody defines parameters for EditCrossOriginResourceSharingCORSSettings.
 type EditCrossOriginResourceSharingCORSSettingsJSONBody struct {
 	Attr *EditCorsAttr `json:"attr,omitempty"`
@@ -1059,6 +1294,22 @@ type QuarantineNotificationsJSONBody struct {
 	Items *map[string]interface{} `json:"items,omitempty"`
 }
 
+// UpdateRecipientMapJSONBody defines parameters for UpdateRecipientMap.
+type UpdateRecipientMapJSONBody struct {
+	Attr *EditRecipientMapAttr `json:"attr,omitempty"`
+
+	// Items contains list of recipient maps you want update
+	Items *[]string `json:"items,omitempty"`
+}
+
+// UpdateSenderDependentTransportsJSONBody defines parameters for UpdateSenderDependentTransports.
+type UpdateSenderDependentTransportsJSONBody struct {
+	Attr *EditRelayhostAttr `json:"attr,omitempty"`
+
+	// Items contains list of Sender-Dependent Transports you want update
+	Items *[]string `json:"items,omitempty"`
+}
+
 // EditDomainRatelimitsJSONBody defines parameters for EditDomainRatelimits.
 type EditDomainRatelimitsJSONBody struct {
 	Attr *EditRatelimitDomainAttr `json:"attr,omitempty"`
@@ -1082,8 +1333,24 @@ type EditMailboxSpamFilterScoreJSONBody = interface{}
 type UpdateSyncJobJSONBody struct {
 	Attr *EditSyncJobAttr `json:"attr,omitempty"`
 
-	// Items contains list of aliases you want update
-	Items *map[string]interface{} `json:"items,omitempty"`
+	// Items contains list of sync jobs you want update
+	Items *[]string `json:"items,omitempty"`
+}
+
+// UpdateTLSPolicyMapJSONBody defines parameters for UpdateTLSPolicyMap.
+type UpdateTLSPolicyMapJSONBody struct {
+	Attr *EditTLSPolicyMapAttr `json:"attr,omitempty"`
+
+	// Items contains list of tls policy maps you want update
+	Items *[]string `json:"items,omitempty"`
+}
+
+// UpdateTransportMapsJSONBody defines parameters for UpdateTransportMaps.
+type UpdateTransportMapsJSONBody struct {
+	Attr *EditTransportAttr `json:"attr,omitempty"`
+
+	// Items contains list of transport maps you want update
+	Items *[]string `json:"items,omitempty"`
 }
 
 // UpdateMailboxACLJSONBody defines parameters for UpdateMailboxACL.
@@ -1313,6 +1580,9 @@ type GenerateDKIMKeyJSONRequestBody GenerateDKIMKeyJSONBody
 // DuplicateDKIMKeyJSONRequestBody defines body for DuplicateDKIMKey for application/json ContentType.
 type DuplicateDKIMKeyJSONRequestBody DuplicateDKIMKeyJSONBody
 
+// ImportDKIMKeyJSONRequestBody defines body for ImportDKIMKey for application/json ContentType.
+type ImportDKIMKeyJSONRequestBody ImportDKIMKeyJSONBody
+
 // CreateDomainJSONRequestBody defines body for CreateDomain for application/json ContentType.
 type CreateDomainJSONRequestBody CreateDomainJSONBody
 
@@ -1359,10 +1629,10 @@ type CreateTransportMapsJSONRequestBody CreateTransportMapsJSONBody
 type DeleteAliasJSONRequestBody = DeleteAliasJSONBody
 
 // DeleteAppPasswordJSONRequestBody defines body for DeleteAppPassword for application/json ContentType.
-type DeleteAppPasswordJSONRequestBody DeleteAppPasswordJSONBody
+type DeleteAppPasswordJSONRequestBody = DeleteAppPasswordJSONBody
 
 // DeleteBCCMapJSONRequestBody defines body for DeleteBCCMap for application/json ContentType.
-type DeleteBCCMapJSONRequestBody DeleteBCCMapJSONBody
+type DeleteBCCMapJSONRequestBody = DeleteBCCMapJSONBody
 
 // DeleteDKIMKeyJSONRequestBody defines body for DeleteDKIMKey for application/json ContentType.
 type DeleteDKIMKeyJSONRequestBody = DeleteDKIMKeyJSONBody
@@ -1374,7 +1644,7 @@ type DeleteDomainJSONRequestBody = DeleteDomainJSONBody
 type DeleteDomainAdminJSONRequestBody = DeleteDomainAdminJSONBody
 
 // DeleteDomainPolicyJSONRequestBody defines body for DeleteDomainPolicy for application/json ContentType.
-type DeleteDomainPolicyJSONRequestBody DeleteDomainPolicyJSONBody
+type DeleteDomainPolicyJSONRequestBody = DeleteDomainPolicyJSONBody
 
 // DeleteDomainTagsJSONRequestBody defines body for DeleteDomainTags for application/json ContentType.
 type DeleteDomainTagsJSONRequestBody DeleteDomainTagsJSONBody
@@ -1398,26 +1668,32 @@ type DeleteOAuthClientJSONRequestBody DeleteOAuthClientJSONBody
 type DeleteMailsInQuarantineJSONRequestBody DeleteMailsInQuarantineJSONBody
 
 // DeleteRecipientMapJSONRequestBody defines body for DeleteRecipientMap for application/json ContentType.
-type DeleteRecipientMapJSONRequestBody DeleteRecipientMapJSONBody
+type DeleteRecipientMapJSONRequestBody = DeleteRecipientMapJSONBody
 
 // DeleteSenderDependentTransportsJSONRequestBody defines body for DeleteSenderDependentTransports for application/json ContentType.
-type DeleteSenderDependentTransportsJSONRequestBody DeleteSenderDependentTransportsJSONBody
+type DeleteSenderDependentTransportsJSONRequestBody = DeleteSenderDependentTransportsJSONBody
 
 // DeleteResourcesJSONRequestBody defines body for DeleteResources for application/json ContentType.
 type DeleteResourcesJSONRequestBody DeleteResourcesJSONBody
 
 // DeleteSyncJobJSONRequestBody defines body for DeleteSyncJob for application/json ContentType.
-type DeleteSyncJobJSONRequestBody DeleteSyncJobJSONBody
+type DeleteSyncJobJSONRequestBody = DeleteSyncJobJSONBody
 
 // DeleteTLSPolicyMapJSONRequestBody defines body for DeleteTLSPolicyMap for application/json ContentType.
-type DeleteTLSPolicyMapJSONRequestBody DeleteTLSPolicyMapJSONBody
+type DeleteTLSPolicyMapJSONRequestBody = DeleteTLSPolicyMapJSONBody
 
 // DeleteTransportMapsJSONRequestBody defines body for DeleteTransportMaps for application/json ContentType.
-type DeleteTransportMapsJSONRequestBody DeleteTransportMapsJSONBody
+type DeleteTransportMapsJSONRequestBody = DeleteTransportMapsJSONBody
 
 // UpdateAliasJSONRequestBody defines body for UpdateAlias for application/json ContentType.
 type UpdateAliasJSONRequestBody UpdateAliasJSONBody
 
+// UpdateAppPasswordJSONRequestBody defines body for UpdateAppPassword for application/json ContentType.
+type UpdateAppPasswordJSONRequestBody UpdateAppPasswordJSONBody
+
+// UpdateBCCMapJSONRequestBody defines body for UpdateBCCMap for application/json ContentType.
+type UpdateBCCMapJSONRequestBody UpdateBCCMapJSONBody
+
 // EditCrossOriginResourceSharingCORSSettingsJSONRequestBody defines body for EditCrossOriginResourceSharingCORSSettings for application/json ContentType.
 type EditCrossOriginResourceSharingCORSSettingsJSONRequestBody EditCrossOriginResourceSharingCORSSettingsJSONBody
 
@@ -1451,6 +1727,12 @@ type UpdatePushoverSettingsJSONRequestBody UpdatePushoverSettingsJSONBody
 // QuarantineNotificationsJSONRequestBody defines body for QuarantineNotifications for application/json ContentType.
 type QuarantineNotificationsJSONRequestBody QuarantineNotificationsJSONBody
 
+// UpdateRecipientMapJSONRequestBody defines body for UpdateRecipientMap for application/json ContentType.
+type UpdateRecipientMapJSONRequestBody UpdateRecipientMapJSONBody
+
+// UpdateSenderDependentTransportsJSONRequestBody defines body for UpdateSenderDependentTransports for application/json ContentType.
+type UpdateSenderDependentTransportsJSONRequestBody UpdateSenderDependentTransportsJSONBody
+
 // EditDomainRatelimitsJSONRequestBody defines body for EditDomainRatelimits for application/json ContentType.
 type EditDomainRatelimitsJSONRequestBody EditDomainRatelimitsJSONBody
 
@@ -1463,6 +1745,12 @@ type EditMailboxSpamFilterScoreJSONRequestBody = EditMailboxSpamFilterScoreJSONB
 // UpdateSyncJobJSONRequestBody defines body for UpdateSyncJob for application/json ContentType.
 type UpdateSyncJobJSONRequestBody UpdateSyncJobJSONBody
 
+// UpdateTLSPolicyMapJSONRequestBody defines body for UpdateTLSPolicyMap for application/json ContentType.
+type UpdateTLSPolicyMapJSONRequestBody UpdateTLSPolicyMapJSONBody
+
+// UpdateTransportMapsJSONRequestBody defines body for UpdateTransportMaps for application/json ContentType.
+type UpdateTransportMapsJSONRequestBody UpdateTransportMapsJSONBody
+
 // UpdateMailboxACLJSONRequestBody defines body for UpdateMailboxACL for application/json ContentType.
 type UpdateMailboxACLJSONRequestBody UpdateMailboxACLJSONBody
 
@@ -1564,6 +1852,11 @@ type ClientInterface interface {
 
 	DuplicateDKIMKey(ctx context.Context, body DuplicateDKIMKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ImportDKIMKeyWithBody request with any body
+	ImportDKIMKeyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ImportDKIMKey(ctx context.Context, body ImportDKIMKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// CreateDomainWithBody request with any body
 	CreateDomainWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -1739,6 +2032,16 @@ type ClientInterface interface {
 
 	UpdateAlias(ctx context.Context, body UpdateAliasJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// UpdateAppPasswordWithBody request with any body
+	UpdateAppPasswordWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateAppPassword(ctx context.Context, body UpdateAppPasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateBCCMapWithBody request with any body
+	UpdateBCCMapWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateBCCMap(ctx context.Context, body UpdateBCCMapJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// EditCrossOriginResourceSharingCORSSettingsWithBody request with any body
 	EditCrossOriginResourceSharingCORSSettingsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -1794,6 +2097,16 @@ type ClientInterface interface {
 
 	QuarantineNotifications(ctx context.Context, body QuarantineNotificationsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// UpdateRecipientMapWithBody request with any body
+	UpdateRecipientMapWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateRecipientMap(ctx context.Context, body UpdateRecipientMapJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateSenderDependentTransportsWithBody request with any body
+	UpdateSenderDependentTransportsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateSenderDependentTransports(ctx context.Context, body UpdateSenderDependentTransportsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// EditDomainRatelimitsWithBody request with any body
 	EditDomainRatelimitsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -1814,6 +2127,16 @@ type ClientInterface interface {
 
 	UpdateSyncJob(ctx context.Context, body UpdateSyncJobJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// UpdateTLSPolicyMapWithBody request with any body
+	UpdateTLSPolicyMapWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateTLSPolicyMap(ctx context.Context, body UpdateTLSPolicyMapJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateTransportMapsWithBody request with any body
+	UpdateTransportMapsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateTransportMaps(ctx context.Context, body UpdateTransportMapsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// UpdateMailboxACLWithBody request with any body
 	UpdateMailboxACLWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -2057,6 +2380,30 @@ func (c *Client) DuplicateDKIMKey(ctx context.Context, body DuplicateDKIMKeyJSON
 	return c.Client.Do(req)
 }
 
+func (c *Client) ImportDKIMKeyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportDKIMKeyRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ImportDKIMKey(ctx context.Context, body ImportDKIMKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportDKIMKeyRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) CreateDomainWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewCreateDomainRequestWithBody(c.Server, contentType, body)
 	if err != nil {
@@ -2897,6 +3244,54 @@ func (c *Client) UpdateAlias(ctx context.Context, body UpdateAliasJSONRequestBod
 	return c.Client.Do(req)
 }
 
+func (c *Client) UpdateAppPasswordWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateAppPasswordRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateAppPassword(ctx context.Context, body UpdateAppPasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateAppPasswordRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateBCCMapWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateBCCMapRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateBCCMap(ctx context.Context, body UpdateBCCMapJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateBCCMapRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) EditCrossOriginResourceSharingCORSSettingsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewEditCrossOriginResourceSharingCORSSettingsRequestWithBody(c.Server, contentType, body)
 	if err != nil {
@@ -3161,6 +3556,54 @@ func (c *Client) QuarantineNotifications(ctx context.Context, body QuarantineNot
 	return c.Client.Do(req)
 }
 
+func (c *Client) UpdateRecipientMapWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateRecipientMapRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateRecipientMap(ctx context.Context, body UpdateRecipientMapJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateRecipientMapRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateSenderDependentTransportsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateSenderDependentTransportsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateSenderDependentTransports(ctx context.Context, body UpdateSenderDependentTransportsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateSenderDependentTransportsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) EditDomainRatelimitsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewEditDomainRatelimitsRequestWithBody(c.Server, contentType, body)
 	if err != nil {
@@ -3257,6 +3700,54 @@ func (c *Client) UpdateSyncJob(ctx context.Context, body UpdateSyncJobJSONReques
 	return c.Client.Do(req)
 }
 
+func (c *Client) UpdateTLSPolicyMapWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTLSPolicyMapRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateTLSPolicyMap(ctx context.Context, body UpdateTLSPolicyMapJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTLSPolicyMapRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateTransportMapsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTransportMapsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateTransportMaps(ctx context.Context, body UpdateTransportMapsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTransportMapsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) UpdateMailboxACLWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewUpdateMailboxACLRequestWithBody(c.Server, contentType, body)
 	if err != nil {
@@ -3889,7 +4380,47 @@ func NewGenerateDKIMKeyRequestWithBody(server string, contentType string, body i
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/add/dkim")
+	operationPath := fmt.Sprintf("/api/v1/add/dkim")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDuplicateDKIMKeyRequest calls the generic DuplicateDKIMKey builder with application/json body
+func NewDuplicateDKIMKeyRequest(server string, body DuplicateDKIMKeyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDuplicateDKIMKeyRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewDuplicateDKIMKeyRequestWithBody generates requests for DuplicateDKIMKey with any type of body
+func NewDuplicateDKIMKeyRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/add/dkim_duplicate")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3909,19 +4440,19 @@ func NewGenerateDKIMKeyRequestWithBody(server string, contentType string, body i
 	return req, nil
 }
 
-// NewDuplicateDKIMKeyRequest calls the generic DuplicateDKIMKey builder with application/json body
-func NewDuplicateDKIMKeyRequest(server string, body DuplicateDKIMKeyJSONRequestBody) (*http.Request, error) {
+// NewImportDKIMKeyRequest calls the generic ImportDKIMKey builder with application/json body
+func NewImportDKIMKeyRequest(server string, body ImportDKIMKeyJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewDuplicateDKIMKeyRequestWithBody(server, "application/json", bodyReader)
+	return NewImportDKIMKeyRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewDuplicateDKIMKeyRequestWithBody generates requests for DuplicateDKIMKey with any type of body
-func NewDuplicateDKIMKeyRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewImportDKIMKeyRequestWithBody generates requests for ImportDKIMKey with any type of body
+func NewImportDKIMKeyRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -3929,7 +4460,7 @@ func NewDuplicateDKIMKeyRequestWithBody(server string, contentType string, body
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/add/dkim_duplicate")
+	operationPath := fmt.Sprintf("/api/v1/add/dkim_import")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5363,6 +5894,86 @@ func NewUpdateAliasRequestWithBody(server string, contentType string, body io.Re
 	return req, nil
 }
 
+// NewUpdateAppPasswordRequest calls the generic UpdateAppPassword builder with application/json body
+func NewUpdateAppPasswordRequest(server string, body UpdateAppPasswordJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateAppPasswordRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUpdateAppPasswordRequestWithBody generates requests for UpdateAppPassword with any type of body
+func NewUpdateAppPasswordRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/edit/app-passwd")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUpdateBCCMapRequest calls the generic UpdateBCCMap builder with application/json body
+func NewUpdateBCCMapRequest(server string, body UpdateBCCMapJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateBCCMapRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUpdateBCCMapRequestWithBody generates requests for UpdateBCCMap with any type of body
+func NewUpdateBCCMapRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/edit/bcc")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
 // NewEditCrossOriginResourceSharingCORSSettingsRequest calls the generic EditCrossOriginResourceSharingCORSSettings builder with application/json body
 func NewEditCrossOriginResourceSharingCORSSettingsRequest(server string, body EditCrossOriginResourceSharingCORSSettingsJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
@@ -5803,6 +6414,86 @@ func NewQuarantineNotificationsRequestWithBody(server string, contentType string
 	return req, nil
 }
 
+// NewUpdateRecipientMapRequest calls the generic UpdateRecipientMap builder with application/json body
+func NewUpdateRecipientMapRequest(server string, body UpdateRecipientMapJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateRecipientMapRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUpdateRecipientMapRequestWithBody generates requests for UpdateRecipientMap with any type of body
+func NewUpdateRecipientMapRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/edit/recipient_map")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUpdateSenderDependentTransportsRequest calls the generic UpdateSenderDependentTransports builder with application/json body
+func NewUpdateSenderDependentTransportsRequest(server string, body UpdateSenderDependentTransportsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateSenderDependentTransportsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUpdateSenderDependentTransportsRequestWithBody generates requests for UpdateSenderDependentTransports with any type of body
+func NewUpdateSenderDependentTransportsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/edit/relayhost")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
 // NewEditDomainRatelimitsRequest calls the generic EditDomainRatelimits builder with application/json body
 func NewEditDomainRatelimitsRequest(server string, body EditDomainRatelimitsJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
@@ -5963,6 +6654,86 @@ func NewUpdateSyncJobRequestWithBody(server string, contentType string, body io.
 	return req, nil
 }
 
+// NewUpdateTLSPolicyMapRequest calls the generic UpdateTLSPolicyMap builder with application/json body
+func NewUpdateTLSPolicyMapRequest(server string, body UpdateTLSPolicyMapJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateTLSPolicyMapRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUpdateTLSPolicyMapRequestWithBody generates requests for UpdateTLSPolicyMap with any type of body
+func NewUpdateTLSPolicyMapRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/edit/tls-policy-map")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUpdateTransportMapsRequest calls the generic UpdateTransportMaps builder with application/json body
+func NewUpdateTransportMapsRequest(server string, body UpdateTransportMapsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateTransportMapsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUpdateTransportMapsRequestWithBody generates requests for UpdateTransportMaps with any type of body
+func NewUpdateTransportMapsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/edit/transport")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
 // NewUpdateMailboxACLRequest calls the generic UpdateMailboxACL builder with application/json body
 func NewUpdateMailboxACLRequest(server string, body UpdateMailboxACLJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
@@ -7784,6 +8555,11 @@ type ClientWithResponsesInterface interface {
 
 	DuplicateDKIMKeyWithResponse(ctx context.Context, body DuplicateDKIMKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*DuplicateDKIMKeyResponse, error)
 
+	// ImportDKIMKeyWithBodyWithResponse request with any body
+	ImportDKIMKeyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportDKIMKeyResponse, error)
+
+	ImportDKIMKeyWithResponse(ctx context.Context, body ImportDKIMKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*ImportDKIMKeyResponse, error)
+
 	// CreateDomainWithBodyWithResponse request with any body
 	CreateDomainWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDomainResponse, error)
 
@@ -7959,6 +8735,16 @@ type ClientWithResponsesInterface interface {
 
 	UpdateAliasWithResponse(ctx context.Context, body UpdateAliasJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAliasResponse, error)
 
+	// UpdateAppPasswordWithBodyWithResponse request with any body
+	UpdateAppPasswordWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAppPasswordResponse, error)
+
+	UpdateAppPasswordWithResponse(ctx context.Context, body UpdateAppPasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAppPasswordResponse, error)
+
+	// UpdateBCCMapWithBodyWithResponse request with any body
+	UpdateBCCMapWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateBCCMapResponse, error)
+
+	UpdateBCCMapWithResponse(ctx context.Context, body UpdateBCCMapJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateBCCMapResponse, error)
+
 	// EditCrossOriginResourceSharingCORSSettingsWithBodyWithResponse request with any body
 	EditCrossOriginResourceSharingCORSSettingsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EditCrossOriginResourceSharingCORSSettingsResponse, error)
 
@@ -8014,6 +8800,16 @@ type ClientWithResponsesInterface interface {
 
 	QuarantineNotificationsWithResponse(ctx context.Context, body QuarantineNotificationsJSONRequestBody, reqEditors ...RequestEditorFn) (*QuarantineNotificationsResponse, error)
 
+	// UpdateRecipientMapWithBodyWithResponse request with any body
+	UpdateRecipientMapWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateRecipientMapResponse, error)
+
+	UpdateRecipientMapWithResponse(ctx context.Context, body UpdateRecipientMapJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateRecipientMapResponse, error)
+
+	// UpdateSenderDependentTransportsWithBodyWithResponse request with any body
+	UpdateSenderDependentTransportsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSenderDependentTransportsResponse, error)
+
+	UpdateSenderDependentTransportsWithResponse(ctx context.Context, body UpdateSenderDependentTransportsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSenderDependentTransportsResponse, error)
+
 	// EditDomainRatelimitsWithBodyWithResponse request with any body
 	EditDomainRatelimitsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EditDomainRatelimitsResponse, error)
 
@@ -8034,6 +8830,16 @@ type ClientWithResponsesInterface interface {
 
 	UpdateSyncJobWithResponse(ctx context.Context, body UpdateSyncJobJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSyncJobResponse, error)
 
+	// UpdateTLSPolicyMapWithBodyWithResponse request with any body
+	UpdateTLSPolicyMapWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTLSPolicyMapResponse, error)
+
+	UpdateTLSPolicyMapWithResponse(ctx context.Context, body UpdateTLSPolicyMapJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTLSPolicyMapResponse, error)
+
+	// UpdateTransportMapsWithBodyWithResponse request with any body
+	UpdateTransportMapsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTransportMapsResponse, error)
+
+	UpdateTransportMapsWithResponse(ctx context.Context, body UpdateTransportMapsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTransportMapsResponse, error)
+
 	// UpdateMailboxACLWithBodyWithResponse request with any body
 	UpdateMailboxACLWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMailboxACLResponse, error)
 
@@ -8252,10 +9058,39 @@ type GenerateDKIMKeyResponse struct {
 	}
 	JSON401 *Unauthorized
 }
-type GenerateDKIMKey200Type string
+type GenerateDKIMKey200Type string
+
+// Status returns HTTPResponse.Status
+func (r GenerateDKIMKeyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GenerateDKIMKeyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DuplicateDKIMKeyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		// Log contains request object
+		Log  *[]interface{}           `json:"log,omitempty"`
+		Msg  *[]interface{}           `json:"msg,omitempty"`
+		Type *DuplicateDKIMKey200Type `json:"type,omitempty"`
+	}
+	JSON401 *Unauthorized
+}
+type DuplicateDKIMKey200Type string
 
 // Status returns HTTPResponse.Status
-func (r GenerateDKIMKeyResponse) Status() string {
+func (r DuplicateDKIMKeyResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8263,28 +9098,28 @@ func (r GenerateDKIMKeyResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GenerateDKIMKeyResponse) StatusCode() int {
+func (r DuplicateDKIMKeyResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DuplicateDKIMKeyResponse struct {
+type ImportDKIMKeyResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON200      *struct {
 		// Log contains request object
-		Log  *[]interface{}           `json:"log,omitempty"`
-		Msg  *[]interface{}           `json:"msg,omitempty"`
-		Type *DuplicateDKIMKey200Type `json:"type,omitempty"`
+		Log  *[]interface{}        `json:"log,omitempty"`
+		Msg  *[]interface{}        `json:"msg,omitempty"`
+		Type *ImportDKIMKey200Type `json:"type,omitempty"`
 	}
 	JSON401 *Unauthorized
 }
-type DuplicateDKIMKey200Type string
+type ImportDKIMKey200Type string
 
 // Status returns HTTPResponse.Status
-func (r DuplicateDKIMKeyResponse) Status() string {
+func (r ImportDKIMKeyResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8292,7 +9127,7 @@ func (r DuplicateDKIMKeyResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DuplicateDKIMKeyResponse) StatusCode() int {
+func (r ImportDKIMKeyResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -9286,6 +10121,58 @@ func (r UpdateAliasResponse) StatusCode() int {
 	return 0
 }
 
+type UpdateAppPasswordResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]struct {
+		Type *UpdateAppPassword200Type `json:"type,omitempty"`
+	}
+	JSON401 *Unauthorized
+}
+type UpdateAppPassword200Type string
+
+// Status returns HTTPResponse.Status
+func (r UpdateAppPasswordResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateAppPasswordResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateBCCMapResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]struct {
+		Type *UpdateBCCMap200Type `json:"type,omitempty"`
+	}
+	JSON401 *Unauthorized
+}
+type UpdateBCCMap200Type string
+
+// Status returns HTTPResponse.Status
+func (r UpdateBCCMapResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateBCCMapResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type EditCrossOriginResourceSharingCORSSettingsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -9579,6 +10466,58 @@ func (r QuarantineNotificationsResponse) StatusCode() int {
 	return 0
 }
 
+type UpdateRecipientMapResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]struct {
+		Type *UpdateRecipientMap200Type `json:"type,omitempty"`
+	}
+	JSON401 *Unauthorized
+}
+type UpdateRecipientMap200Type string
+
+// Status returns HTTPResponse.Status
+func (r UpdateRecipientMapResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateRecipientMapResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateSenderDependentTransportsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]struct {
+		Type *UpdateSenderDependentTransports200Type `json:"type,omitempty"`
+	}
+	JSON401 *Unauthorized
+}
+type UpdateSenderDependentTransports200Type string
+
+// Status returns HTTPResponse.Status
+func (r UpdateSenderDependentTransportsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateSenderDependentTransportsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type EditDomainRatelimitsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -9692,6 +10631,58 @@ func (r UpdateSyncJobResponse) StatusCode() int {
 	return 0
 }
 
+type UpdateTLSPolicyMapResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]struct {
+		Type *UpdateTLSPolicyMap200Type `json:"type,omitempty"`
+	}
+	JSON401 *Unauthorized
+}
+type UpdateTLSPolicyMap200Type string
+
+// Status returns HTTPResponse.Status
+func (r UpdateTLSPolicyMapResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateTLSPolicyMapResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateTransportMapsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]struct {
+		Type *UpdateTransportMaps200Type `json:"type,omitempty"`
+	}
+	JSON401 *Unauthorized
+}
+type UpdateTransportMaps200Type string
+
+// Status returns HTTPResponse.Status
+func (r UpdateTransportMapsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateTransportMapsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type UpdateMailboxACLResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -9724,20 +10715,8 @@ func (r UpdateMailboxACLResponse) StatusCode() int {
 type GetAliasesResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *struct {
-		Active          *int    `json:"active,omitempty"`
-		Address         *string `json:"address,omitempty"`
-		Created         *string `json:"created,omitempty"`
-		Domain          *string `json:"domain,omitempty"`
-		Goto            *string `json:"goto,omitempty"`
-		Id              *int    `json:"id,omitempty"`
-		InPrimaryDomain *string `json:"in_primary_domain,omitempty"`
-		IsCatchAll      *int    `json:"is_catch_all,omitempty"`
-		Modified        *string `json:"modified"`
-		PrivateComment  *string `json:"private_comment"`
-		PublicComment   *string `json:"public_comment"`
-	}
-	JSON401 *Unauthorized
+	JSON200      *Alias
+	JSON401      *Unauthorized
 }
 
 // Status returns HTTPResponse.Status
@@ -9851,15 +10830,8 @@ func (r GetDKIMKeyResponse) StatusCode() int {
 type GetDomainAdminsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]struct {
-		Active            *int      `json:"active,omitempty"`
-		Created           *string   `json:"created,omitempty"`
-		SelectedDomains   *[]string `json:"selected_domains,omitempty"`
-		TfaActive         *int      `json:"tfa_active,omitempty"`
-		UnselectedDomains *[]string `json:"unselected_domains,omitempty"`
-		Username          *string   `json:"username,omitempty"`
-	}
-	JSON401 *Unauthorized
+	JSON200      *[]DomainAdmin
+	JSON401      *Unauthorized
 }
 
 // Status returns HTTPResponse.Status
@@ -9881,31 +10853,8 @@ func (r GetDomainAdminsResponse) StatusCode() int {
 type GetDomainsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *struct {
-		Active                 *int      `json:"active,omitempty"`
-		AliasesInDomain        *int      `json:"aliases_in_domain,omitempty"`
-		AliasesLeft            *int      `json:"aliases_left,omitempty"`
-		Backupmx               *int      `json:"backupmx,omitempty"`
-		BytesTotal             *int      `json:"bytes_total,omitempty"`
-		DefNewMailboxQuota     *int      `json:"def_new_mailbox_quota,omitempty"`
-		DefQuotaForMbox        *int      `json:"def_quota_for_mbox,omitempty"`
-		Description            *string   `json:"description,omitempty"`
-		DomainName             *string   `json:"domain_name,omitempty"`
-		Gal                    *int      `json:"gal,omitempty"`
-		MaxNewMailboxQuota     *int      `json:"max_new_mailbox_quota,omitempty"`
-		MaxNumAliasesForDomain *int      `json:"max_num_aliases_for_domain,omitempty"`
-		MaxNumMboxesForDomain  *int      `json:"max_num_mboxes_for_domain,omitempty"`
-		MaxQuotaForDomain      *int      `json:"max_quota_for_domain,omitempty"`
-		MaxQuotaForMbox        *int      `json:"max_quota_for_mbox,omitempty"`
-		MboxesInDomain         *int      `json:"mboxes_in_domain,omitempty"`
-		MboxesLeft             *int      `json:"mboxes_left,omitempty"`
-		MsgsTotal              *int      `json:"msgs_total,omitempty"`
-		QuotaUsedInDomain      *string   `json:"quota_used_in_domain,omitempty"`
-		RelayAllRecipients     *float32  `json:"relay_all_recipients,omitempty"`
-		Relayhost              *string   `json:"relayhost,omitempty"`
-		Tags                   *[]string `json:"tags,omitempty"`
-	}
-	JSON401 *Unauthorized
+	JSON200      *Domain
+	JSON401      *Unauthorized
 }
 
 // Status returns HTTPResponse.Status
@@ -10318,30 +11267,8 @@ func (r GetMailboxesOfADomainResponse) StatusCode() int {
 type GetMailboxesResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *struct {
-		Active     *int `json:"active,omitempty"`
-		Attributes *struct {
-			ForcePwUpdate          *string `json:"force_pw_update,omitempty"`
-			MailboxFormat          *string `json:"mailbox_format,omitempty"`
-			QuarantineNotification *string `json:"quarantine_notification,omitempty"`
-			SogoAccess             *string `json:"sogo_access,omitempty"`
-			TlsEnforceIn           *string `json:"tls_enforce_in,omitempty"`
-			TlsEnforceOut          *string `json:"tls_enforce_out,omitempty"`
-		} `json:"attributes,omitempty"`
-		Domain       *string   `json:"domain,omitempty"`
-		IsRelayed    *int      `json:"is_relayed,omitempty"`
-		LocalPart    *string   `json:"local_part,omitempty"`
-		MaxNewQuota  *int      `json:"max_new_quota,omitempty"`
-		Messages     *int      `json:"messages,omitempty"`
-		Name         *string   `json:"name,omitempty"`
-		PercentClass *string   `json:"percent_class,omitempty"`
-		Quota        *int      `json:"quota,omitempty"`
-		QuotaUsed    *int      `json:"quota_used,omitempty"`
-		SpamAliases  *int      `json:"spam_aliases,omitempty"`
-		Tags         *[]string `json:"tags,omitempty"`
-		Username     *string   `json:"username,omitempty"`
-	}
-	JSON401 *Unauthorized
+	JSON200      *Mailbox
+	JSON401      *Unauthorized
 }
 
 // Status returns HTTPResponse.Status
@@ -10794,39 +11721,8 @@ func (r GetVmailStatusResponse) StatusCode() int {
 type GetSyncJobsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]struct {
-		Active              *string `json:"active,omitempty"`
-		Authmd51            *int    `json:"authmd51,omitempty"`
-		Authmech1           *string `json:"authmech1,omitempty"`
-		Automap             *int    `json:"automap,omitempty"`
-		Created             *string `json:"created,omitempty"`
-		CustomParams        *string `json:"custom_params,omitempty"`
-		Delete1             *int    `json:"delete1,omitempty"`
-		Delete2             *int    `json:"delete2,omitempty"`
-		Delete2duplicates   *int    `json:"delete2duplicates,omitempty"`
-		Domain2             *string `json:"domain2,omitempty"`
-		Enc1                *string `json:"enc1,omitempty"`
-		Exclude             *string `json:"exclude,omitempty"`
-		Host1               *string `json:"host1,omitempty"`
-		Id                  *int    `json:"id,omitempty"`
-		IsRunning           *int    `json:"is_running,omitempty"`
-		LastRun             *string `json:"last_run,omitempty"`
-		Log                 *string `json:"log,omitempty"`
-		Maxage              *int    `json:"maxage,omitempty"`
-		Maxbytespersecond   *string `json:"maxbytespersecond,omitempty"`
-		MinsInterval        *string `json:"mins_interval,omitempty"`
-		Modified            *string `json:"modified,omitempty"`
-		Port1               *int    `json:"port1,omitempty"`
-		Regextrans2         *string `json:"regextrans2,omitempty"`
-		Skipcrossduplicates *int    `json:"skipcrossduplicates,omitempty"`
-		Subfolder2          *string `json:"subfolder2,omitempty"`
-		Subscribeall        *int    `json:"subscribeall,omitempty"`
-		Timeout1            *int    `json:"timeout1,omitempty"`
-		Timeout2            *int    `json:"timeout2,omitempty"`
-		User1               *string `json:"user1,omitempty"`
-		User2               *string `json:"user2,omitempty"`
-	}
-	JSON401 *Unauthorized
+	JSON200      *[]SyncJob
+	JSON401      *Unauthorized
 }
 
 // Status returns HTTPResponse.Status
@@ -11022,6 +11918,23 @@ func (c *ClientWithResponses) DuplicateDKIMKeyWithResponse(ctx context.Context,
 	return ParseDuplicateDKIMKeyResponse(rsp)
 }
 
+// ImportDKIMKeyWithBodyWithResponse request with arbitrary body returning *ImportDKIMKeyResponse
+func (c *ClientWithResponses) ImportDKIMKeyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportDKIMKeyResponse, error) {
+	rsp, err := c.ImportDKIMKeyWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportDKIMKeyResponse(rsp)
+}
+
+func (c *ClientWithResponses) ImportDKIMKeyWithResponse(ctx context.Context, body ImportDKIMKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*ImportDKIMKeyResponse, error) {
+	rsp, err := c.ImportDKIMKey(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportDKIMKeyResponse(rsp)
+}
+
 // CreateDomainWithBodyWithResponse request with arbitrary body returning *CreateDomainResponse
 func (c *ClientWithResponses) CreateDomainWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDomainResponse, error) {
 	rsp, err := c.CreateDomainWithBody(ctx, contentType, body, reqEditors...)
@@ -11617,6 +12530,40 @@ func (c *ClientWithResponses) UpdateAliasWithResponse(ctx context.Context, body
 	return ParseUpdateAliasResponse(rsp)
 }
 
+// UpdateAppPasswordWithBodyWithResponse request with arbitrary body returning *UpdateAppPasswordResponse
+func (c *ClientWithResponses) UpdateAppPasswordWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAppPasswordResponse, error) {
+	rsp, err := c.UpdateAppPasswordWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateAppPasswordResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateAppPasswordWithResponse(ctx context.Context, body UpdateAppPasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAppPasswordResponse, error) {
+	rsp, err := c.UpdateAppPassword(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateAppPasswordResponse(rsp)
+}
+
+// UpdateBCCMapWithBodyWithResponse request with arbitrary body returning *UpdateBCCMapResponse
+func (c *ClientWithResponses) UpdateBCCMapWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateBCCMapResponse, error) {
+	rsp, err := c.UpdateBCCMapWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateBCCMapResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateBCCMapWithResponse(ctx context.Context, body UpdateBCCMapJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateBCCMapResponse, error) {
+	rsp, err := c.UpdateBCCMap(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateBCCMapResponse(rsp)
+}
+
 // EditCrossOriginResourceSharingCORSSettingsWithBodyWithResponse request with arbitrary body returning *EditCrossOriginResourceSharingCORSSettingsResponse
 func (c *ClientWithResponses) EditCrossOriginResourceSharingCORSSettingsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EditCrossOriginResourceSharingCORSSettingsResponse, error) {
 	rsp, err := c.EditCrossOriginResourceSharingCORSSettingsWithBody(ctx, contentType, body, reqEditors...)
@@ -11804,6 +12751,40 @@ func (c *ClientWithResponses) QuarantineNotificationsWithResponse(ctx context.Co
 	return ParseQuarantineNotificationsResponse(rsp)
 }
 
+// UpdateRecipientMapWithBodyWithResponse request with arbitrary body returning *UpdateRecipientMapResponse
+func (c *ClientWithResponses) UpdateRecipientMapWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateRecipientMapResponse, error) {
+	rsp, err := c.UpdateRecipientMapWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateRecipientMapResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateRecipientMapWithResponse(ctx context.Context, body UpdateRecipientMapJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateRecipientMapResponse, error) {
+	rsp, err := c.UpdateRecipientMap(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateRecipientMapResponse(rsp)
+}
+
+// UpdateSenderDependentTransportsWithBodyWithResponse request with arbitrary body returning *UpdateSenderDependentTransportsResponse
+func (c *ClientWithResponses) UpdateSenderDependentTransportsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSenderDependentTransportsResponse, error) {
+	rsp, err := c.UpdateSenderDependentTransportsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateSenderDependentTransportsResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateSenderDependentTransportsWithResponse(ctx context.Context, body UpdateSenderDependentTransportsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSenderDependentTransportsResponse, error) {
+	rsp, err := c.UpdateSenderDependentTransports(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateSenderDependentTransportsResponse(rsp)
+}
+
 // EditDomainRatelimitsWithBodyWithResponse request with arbitrary body returning *EditDomainRatelimitsResponse
 func (c *ClientWithResponses) EditDomainRatelimitsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EditDomainRatelimitsResponse, error) {
 	rsp, err := c.EditDomainRatelimitsWithBody(ctx, contentType, body, reqEditors...)
@@ -11838,38 +12819,72 @@ func (c *ClientWithResponses) EditMailboxRatelimitsWithResponse(ctx context.Cont
 	return ParseEditMailboxRatelimitsResponse(rsp)
 }
 
-// EditMailboxSpamFilterScoreWithBodyWithResponse request with arbitrary body returning *EditMailboxSpamFilterScoreResponse
-func (c *ClientWithResponses) EditMailboxSpamFilterScoreWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EditMailboxSpamFilterScoreResponse, error) {
-	rsp, err := c.EditMailboxSpamFilterScoreWithBody(ctx, contentType, body, reqEditors...)
+// EditMailboxSpamFilterScoreWithBodyWithResponse request with arbitrary body returning *EditMailboxSpamFilterScoreResponse
+func (c *ClientWithResponses) EditMailboxSpamFilterScoreWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EditMailboxSpamFilterScoreResponse, error) {
+	rsp, err := c.EditMailboxSpamFilterScoreWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEditMailboxSpamFilterScoreResponse(rsp)
+}
+
+func (c *ClientWithResponses) EditMailboxSpamFilterScoreWithResponse(ctx context.Context, body EditMailboxSpamFilterScoreJSONRequestBody, reqEditors ...RequestEditorFn) (*EditMailboxSpamFilterScoreResponse, error) {
+	rsp, err := c.EditMailboxSpamFilterScore(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEditMailboxSpamFilterScoreResponse(rsp)
+}
+
+// UpdateSyncJobWithBodyWithResponse request with arbitrary body returning *UpdateSyncJobResponse
+func (c *ClientWithResponses) UpdateSyncJobWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSyncJobResponse, error) {
+	rsp, err := c.UpdateSyncJobWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateSyncJobResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateSyncJobWithResponse(ctx context.Context, body UpdateSyncJobJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSyncJobResponse, error) {
+	rsp, err := c.UpdateSyncJob(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateSyncJobResponse(rsp)
+}
+
+// UpdateTLSPolicyMapWithBodyWithResponse request with arbitrary body returning *UpdateTLSPolicyMapResponse
+func (c *ClientWithResponses) UpdateTLSPolicyMapWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTLSPolicyMapResponse, error) {
+	rsp, err := c.UpdateTLSPolicyMapWithBody(ctx, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseEditMailboxSpamFilterScoreResponse(rsp)
+	return ParseUpdateTLSPolicyMapResponse(rsp)
 }
 
-func (c *ClientWithResponses) EditMailboxSpamFilterScoreWithResponse(ctx context.Context, body EditMailboxSpamFilterScoreJSONRequestBody, reqEditors ...RequestEditorFn) (*EditMailboxSpamFilterScoreResponse, error) {
-	rsp, err := c.EditMailboxSpamFilterScore(ctx, body, reqEditors...)
+func (c *ClientWithResponses) UpdateTLSPolicyMapWithResponse(ctx context.Context, body UpdateTLSPolicyMapJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTLSPolicyMapResponse, error) {
+	rsp, err := c.UpdateTLSPolicyMap(ctx, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseEditMailboxSpamFilterScoreResponse(rsp)
+	return ParseUpdateTLSPolicyMapResponse(rsp)
 }
 
-// UpdateSyncJobWithBodyWithResponse request with arbitrary body returning *UpdateSyncJobResponse
-func (c *ClientWithResponses) UpdateSyncJobWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSyncJobResponse, error) {
-	rsp, err := c.UpdateSyncJobWithBody(ctx, contentType, body, reqEditors...)
+// UpdateTransportMapsWithBodyWithResponse request with arbitrary body returning *UpdateTransportMapsResponse
+func (c *ClientWithResponses) UpdateTransportMapsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTransportMapsResponse, error) {
+	rsp, err := c.UpdateTransportMapsWithBody(ctx, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateSyncJobResponse(rsp)
+	return ParseUpdateTransportMapsResponse(rsp)
 }
 
-func (c *ClientWithResponses) UpdateSyncJobWithResponse(ctx context.Context, body UpdateSyncJobJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSyncJobResponse, error) {
-	rsp, err := c.UpdateSyncJob(ctx, body, reqEditors...)
+func (c *ClientWithResponses) UpdateTransportMapsWithResponse(ctx context.Context, body UpdateTransportMapsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTransportMapsResponse, error) {
+	rsp, err := c.UpdateTransportMaps(ctx, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateSyncJobResponse(rsp)
+	return ParseUpdateTransportMapsResponse(rsp)
 }
 
 // UpdateMailboxACLWithBodyWithResponse request with arbitrary body returning *UpdateMailboxACLResponse
@@ -12427,6 +13442,44 @@ func ParseDuplicateDKIMKeyResponse(rsp *http.Response) (*DuplicateDKIMKeyRespons
 	return response, nil
 }
 
+// ParseImportDKIMKeyResponse parses an HTTP response from a ImportDKIMKeyWithResponse call
+func ParseImportDKIMKeyResponse(rsp *http.Response) (*ImportDKIMKeyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ImportDKIMKeyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			// Log contains request object
+			Log  *[]interface{}        `json:"log,omitempty"`
+			Msg  *[]interface{}        `json:"msg,omitempty"`
+			Type *ImportDKIMKey200Type `json:"type,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseCreateDomainResponse parses an HTTP response from a CreateDomainWithResponse call
 func ParseCreateDomainResponse(rsp *http.Response) (*CreateDomainResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -13731,6 +14784,76 @@ func ParseUpdateAliasResponse(rsp *http.Response) (*UpdateAliasResponse, error)
 	return response, nil
 }
 
+// ParseUpdateAppPasswordResponse parses an HTTP response from a UpdateAppPasswordWithResponse call
+func ParseUpdateAppPasswordResponse(rsp *http.Response) (*UpdateAppPasswordResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateAppPasswordResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []struct {
+			Type *UpdateAppPassword200Type `json:"type,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateBCCMapResponse parses an HTTP response from a UpdateBCCMapWithResponse call
+func ParseUpdateBCCMapResponse(rsp *http.Response) (*UpdateBCCMapResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateBCCMapResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []struct {
+			Type *UpdateBCCMap200Type `json:"type,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseEditCrossOriginResourceSharingCORSSettingsResponse parses an HTTP response from a EditCrossOriginResourceSharingCORSSettingsWithResponse call
 func ParseEditCrossOriginResourceSharingCORSSettingsResponse(rsp *http.Response) (*EditCrossOriginResourceSharingCORSSettingsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -14114,6 +15237,76 @@ func ParseQuarantineNotificationsResponse(rsp *http.Response) (*QuarantineNotifi
 	return response, nil
 }
 
+// ParseUpdateRecipientMapResponse parses an HTTP response from a UpdateRecipientMapWithResponse call
+func ParseUpdateRecipientMapResponse(rsp *http.Response) (*UpdateRecipientMapResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateRecipientMapResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []struct {
+			Type *UpdateRecipientMap200Type `json:"type,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateSenderDependentTransportsResponse parses an HTTP response from a UpdateSenderDependentTransportsWithResponse call
+func ParseUpdateSenderDependentTransportsResponse(rsp *http.Response) (*UpdateSenderDependentTransportsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateSenderDependentTransportsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []struct {
+			Type *UpdateSenderDependentTransports200Type `json:"type,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseEditDomainRatelimitsResponse parses an HTTP response from a EditDomainRatelimitsWithResponse call
 func ParseEditDomainRatelimitsResponse(rsp *http.Response) (*EditDomainRatelimitsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -14263,6 +15456,76 @@ func ParseUpdateSyncJobResponse(rsp *http.Response) (*UpdateSyncJobResponse, err
 	return response, nil
 }
 
+// ParseUpdateTLSPolicyMapResponse parses an HTTP response from a UpdateTLSPolicyMapWithResponse call
+func ParseUpdateTLSPolicyMapResponse(rsp *http.Response) (*UpdateTLSPolicyMapResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateTLSPolicyMapResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []struct {
+			Type *UpdateTLSPolicyMap200Type `json:"type,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateTransportMapsResponse parses an HTTP response from a UpdateTransportMapsWithResponse call
+func ParseUpdateTransportMapsResponse(rsp *http.Response) (*UpdateTransportMapsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateTransportMapsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []struct {
+			Type *UpdateTransportMaps200Type `json:"type,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseUpdateMailboxACLResponse parses an HTTP response from a UpdateMailboxACLWithResponse call
 func ParseUpdateMailboxACLResponse(rsp *http.Response) (*UpdateMailboxACLResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -14316,19 +15579,7 @@ func ParseGetAliasesResponse(rsp *http.Response) (*GetAliasesResponse, error) {
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			Active          *int    `json:"active,omitempty"`
-			Address         *string `json:"address,omitempty"`
-			Created         *string `json:"created,omitempty"`
-			Domain          *string `json:"domain,omitempty"`
-			Goto            *string `json:"goto,omitempty"`
-			Id              *int    `json:"id,omitempty"`
-			InPrimaryDomain *string `json:"in_primary_domain,omitempty"`
-			IsCatchAll      *int    `json:"is_catch_all,omitempty"`
-			Modified        *string `json:"modified"`
-			PrivateComment  *string `json:"private_comment"`
-			PublicComment   *string `json:"public_comment"`
-		}
+		var dest Alias
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -14483,14 +15734,7 @@ func ParseGetDomainAdminsResponse(rsp *http.Response) (*GetDomainAdminsResponse,
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []struct {
-			Active            *int      `json:"active,omitempty"`
-			Created           *string   `json:"created,omitempty"`
-			SelectedDomains   *[]string `json:"selected_domains,omitempty"`
-			TfaActive         *int      `json:"tfa_active,omitempty"`
-			UnselectedDomains *[]string `json:"unselected_domains,omitempty"`
-			Username          *string   `json:"username,omitempty"`
-		}
+		var dest []DomainAdmin
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -14523,30 +15767,7 @@ func ParseGetDomainsResponse(rsp *http.Response) (*GetDomainsResponse, error) {
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			Active                 *int      `json:"active,omitempty"`
-			AliasesInDomain        *int      `json:"aliases_in_domain,omitempty"`
-			AliasesLeft            *int      `json:"aliases_left,omitempty"`
-			Backupmx               *int      `json:"backupmx,omitempty"`
-			BytesTotal             *int      `json:"bytes_total,omitempty"`
-			DefNewMailboxQuota     *int      `json:"def_new_mailbox_quota,omitempty"`
-			DefQuotaForMbox        *int      `json:"def_quota_for_mbox,omitempty"`
-			Description            *string   `json:"description,omitempty"`
-			DomainName             *string   `json:"domain_name,omitempty"`
-			Gal                    *int      `json:"gal,omitempty"`
-			MaxNewMailboxQuota     *int      `json:"max_new_mailbox_quota,omitempty"`
-			MaxNumAliasesForDomain *int      `json:"max_num_aliases_for_domain,omitempty"`
-			MaxNumMboxesForDomain  *int      `json:"max_num_mboxes_for_domain,omitempty"`
-			MaxQuotaForDomain      *int      `json:"max_quota_for_domain,omitempty"`
-			MaxQuotaForMbox        *int      `json:"max_quota_for_mbox,omitempty"`
-			MboxesInDomain         *int      `json:"mboxes_in_domain,omitempty"`
-			MboxesLeft             *int      `json:"mboxes_left,omitempty"`
-			MsgsTotal              *int      `json:"msgs_total,omitempty"`
-			QuotaUsedInDomain      *string   `json:"quota_used_in_domain,omitempty"`
-			RelayAllRecipients     *float32  `json:"relay_all_recipients,omitempty"`
-			Relayhost              *string   `json:"relayhost,omitempty"`
-			Tags                   *[]string `json:"tags,omitempty"`
-		}
+		var dest Domain
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -15094,29 +16315,7 @@ func ParseGetMailboxesResponse(rsp *http.Response) (*GetMailboxesResponse, error
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			Active     *int `json:"active,omitempty"`
-			Attributes *struct {
-				ForcePwUpdate          *string `json:"force_pw_update,omitempty"`
-				MailboxFormat          *string `json:"mailbox_format,omitempty"`
-				QuarantineNotification *string `json:"quarantine_notification,omitempty"`
-				SogoAccess             *string `json:"sogo_access,omitempty"`
-				TlsEnforceIn           *string `json:"tls_enforce_in,omitempty"`
-				TlsEnforceOut          *string `json:"tls_enforce_out,omitempty"`
-			} `json:"attributes,omitempty"`
-			Domain       *string   `json:"domain,omitempty"`
-			IsRelayed    *int      `json:"is_relayed,omitempty"`
-			LocalPart    *string   `json:"local_part,omitempty"`
-			MaxNewQuota  *int      `json:"max_new_quota,omitempty"`
-			Messages     *int      `json:"messages,omitempty"`
-			Name         *string   `json:"name,omitempty"`
-			PercentClass *string   `json:"percent_class,omitempty"`
-			Quota        *int      `json:"quota,omitempty"`
-			QuotaUsed    *int      `json:"quota_used,omitempty"`
-			SpamAliases  *int      `json:"spam_aliases,omitempty"`
-			Tags         *[]string `json:"tags,omitempty"`
-			Username     *string   `json:"username,omitempty"`
-		}
+		var dest Mailbox
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -15730,38 +16929,7 @@ func ParseGetSyncJobsResponse(rsp *http.Response) (*GetSyncJobsResponse, error)
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []struct {
-			Active              *string `json:"active,omitempty"`
-			Authmd51            *int    `json:"authmd51,omitempty"`
-			Authmech1           *string `json:"authmech1,omitempty"`
-			Automap             *int    `json:"automap,omitempty"`
-			Created             *string `json:"created,omitempty"`
-			CustomParams        *string `json:"custom_params,omitempty"`
-			Delete1             *int    `json:"delete1,omitempty"`
-			Delete2             *int    `json:"delete2,omitempty"`
-			Delete2duplicates   *int    `json:"delete2duplicates,omitempty"`
-			Domain2             *string `json:"domain2,omitempty"`
-			Enc1                *string `json:"enc1,omitempty"`
-			Exclude             *string `json:"exclude,omitempty"`
-			Host1               *string `json:"host1,omitempty"`
-			Id                  *int    `json:"id,omitempty"`
-			IsRunning           *int    `json:"is_running,omitempty"`
-			LastRun             *string `json:"last_run,omitempty"`
-			Log                 *string `json:"log,omitempty"`
-			Maxage              *int    `json:"maxage,omitempty"`
-			Maxbytespersecond   *string `json:"maxbytespersecond,omitempty"`
-			MinsInterval        *string `json:"mins_interval,omitempty"`
-			Modified            *string `json:"modified,omitempty"`
-			Port1               *int    `json:"port1,omitempty"`
-			Regextrans2         *string `json:"regextrans2,omitempty"`
-			Skipcrossduplicates *int    `json:"skipcrossduplicates,omitempty"`
-			Subfolder2          *string `json:"subfolder2,omitempty"`
-			Subscribeall        *int    `json:"subscribeall,omitempty"`
-			Timeout1            *int    `json:"timeout1,omitempty"`
-			Timeout2            *int    `json:"timeout2,omitempty"`
-			User1               *string `json:"user1,omitempty"`
-			User2               *string `json:"user2,omitempty"`
-		}
+		var dest []SyncJob
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}